@@ -0,0 +1,185 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/cassette"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// cassetteSeed seeds the random number generator used by sdkacctest when recording or replaying,
+// so that random values other than names generated by sdkacctest.RandomWithPrefix(ResourcePrefix),
+// which cassettes replace with placeholders, are the same when a test is recorded and replayed.
+const cassetteSeed = 1
+
+// cassettes holds the cassette of the test that is recording or replaying.
+// Provider and the provider instances returned by the ProviderFactories functions all send their
+// requests to it, so tests must run one at a time.
+var cassettes struct {
+	sync.Mutex
+	current *cassette.Cassette
+}
+
+func init() {
+	if recording() || replaying() {
+		rand.Seed(cassetteSeed)
+	}
+}
+
+// setenv sets an environment variable, or unsets it if value is empty, for the duration of the test.
+// t.Setenv can't be used as it panics in tests that have called t.Parallel, which resource.ParallelTest does.
+func setenv(t *testing.T, key, value string) {
+	prev, ok := os.LookupEnv(key)
+
+	if value == "" {
+		os.Unsetenv(key)
+	} else {
+		os.Setenv(key, value)
+	}
+
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, prev)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// recording returns whether acceptance tests record their AWS API interactions.
+func recording() bool {
+	return os.Getenv(conns.EnvVarAccRecord) != ""
+}
+
+// replaying returns whether acceptance tests replay their recorded AWS API interactions.
+func replaying() bool {
+	return os.Getenv(conns.EnvVarAccReplay) != ""
+}
+
+// newProvider returns a new provider instance.
+// When recording or replaying, the provider sends AWS API requests to the current test's cassette.
+func newProvider() *schema.Provider {
+	p := provider.Provider()
+
+	if !recording() && !replaying() {
+		return p
+	}
+
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configure(conns.WithHTTPTransport(ctx, func(next http.RoundTripper) http.RoundTripper {
+			return cassetteTransport{next: next}
+		}), d)
+	}
+
+	return p
+}
+
+// cassetteTransport records AWS API requests to, or replays them from, the current test's cassette.
+type cassetteTransport struct {
+	next http.RoundTripper
+}
+
+func (t cassetteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	cassettes.Lock()
+	c := cassettes.current
+	cassettes.Unlock()
+
+	if c == nil {
+		return nil, errors.New("AWS API request made outside of a recording or replaying acceptance test")
+	}
+
+	if replaying() {
+		return c.Replay(r)
+	}
+
+	return c.Record(r, t.next)
+}
+
+// cassetteDir is the directory of cassette files, relative to the test's package directory.
+var cassetteDir = filepath.Join("testdata", "cassettes")
+
+// cassettePath returns the path of the test's cassette.
+func cassettePath(t *testing.T) string {
+	return filepath.Join(cassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// preCheckCassette starts recording or replaying the test's AWS API interactions and configures Provider to use them.
+// Tests without a cassette are skipped when replaying.
+func preCheckCassette(t *testing.T) {
+	path := cassettePath(t)
+
+	var c *cassette.Cassette
+
+	if replaying() {
+		var err error
+
+		c, err = cassette.Load(path)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("skipping test; no recorded AWS API interactions (%s)", path)
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Recorded interactions don't depend on credentials.
+		setenv(t, conns.EnvVarAccessKeyId, cassette.FakeAccessKeyID)
+		setenv(t, conns.EnvVarSecretAccessKey, "replay")
+		setenv(t, conns.EnvVarProfile, "")
+		setenv(t, "AWS_SESSION_TOKEN", "")
+	} else {
+		preCheckCredentials(t)
+
+		c = cassette.New()
+	}
+
+	c.SensitiveRequestFields = conns.SensitiveFieldNames
+
+	// Random values generated after the PreCheck, e.g. by configuration functions, are the same
+	// however many tests ran before this one.
+	rand.Seed(cassetteSeed)
+
+	cassettes.Lock()
+
+	if cassettes.current != nil {
+		cassettes.Unlock()
+		t.Fatalf("recording or replaying AWS API interactions requires acceptance tests to run one at a time, use -parallel 1")
+	}
+
+	cassettes.current = c
+	cassettes.Unlock()
+
+	t.Cleanup(func() {
+		cassettes.Lock()
+		cassettes.current = nil
+		cassettes.Unlock()
+
+		if recording() && !t.Failed() && !t.Skipped() {
+			if err := c.Save(path); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	setenv(t, conns.EnvVarDefaultRegion, Region())
+
+	// Provider is reconfigured for each test so that its configuration requests are in each test's cassette.
+	if err := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); err != nil {
+		t.Fatal(err)
+	}
+}
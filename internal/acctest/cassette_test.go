@@ -0,0 +1,111 @@
+package acctest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestPreCheckCassette(t *testing.T) {
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	t.Setenv("AWS_ENDPOINT_URL", server.URL)
+	t.Setenv(conns.EnvVarAccessKeyId, fakeaws.AccessKeyID)
+	t.Setenv(conns.EnvVarSecretAccessKey, fakeaws.SecretAccessKey)
+	t.Setenv(conns.EnvVarProfile, "")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv(conns.EnvVarDefaultRegion, Region())
+
+	previousCassetteDir, previousProvider := cassetteDir, Provider
+	cassetteDir = t.TempDir()
+
+	defer func() {
+		cassetteDir, Provider = previousCassetteDir, previousProvider
+	}()
+
+	putAndGetParameter := func(t *testing.T) string {
+		name := sdkacctest.RandomWithPrefix(ResourcePrefix)
+		conn := Provider.Meta().(*conns.AWSClient).SSMConn
+
+		if _, err := conn.PutParameter(&ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  aws.String(ssm.ParameterTypeString),
+			Value: aws.String("test"),
+		}); err != nil {
+			t.Fatalf("putting parameter: %s", err)
+		}
+
+		output, err := conn.GetParameter(&ssm.GetParameterInput{Name: aws.String(name)})
+
+		if err != nil {
+			t.Fatalf("getting parameter: %s", err)
+		}
+
+		if got, want := aws.StringValue(output.Parameter.Name), name; got != want {
+			t.Errorf("got parameter name %q, want %q", got, want)
+		}
+
+		return aws.StringValue(output.Parameter.ARN)
+	}
+
+	var recordedARN string
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv(conns.EnvVarAccRecord, "1")
+
+		Provider = newProvider()
+		PreCheck(t)
+
+		recordedARN = putAndGetParameter(t)
+	})
+
+	recordedPath := filepath.Join(cassetteDir, "TestPreCheckCassette_record.json")
+	b, err := os.ReadFile(recordedPath)
+
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(cassetteDir, "TestPreCheckCassette_replay.json"), b, 0644); err != nil {
+		t.Fatalf("writing cassette: %s", err)
+	}
+
+	server.Close()
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv(conns.EnvVarAccReplay, "1")
+
+		Provider = newProvider()
+		PreCheck(t)
+
+		// PreCheck reseeds the random number generator, so draw a number to make the replaying test's
+		// random name differ from the recorded one.
+		sdkacctest.RandInt()
+
+		replayedARN := putAndGetParameter(t)
+
+		if replayedARN == recordedARN {
+			t.Errorf("expected replayed parameter ARN to contain the replaying test's random name, got %q", replayedARN)
+		}
+
+		if got, want := Provider.Meta().(*conns.AWSClient).AccountID, fakeaws.AccountID; got != want {
+			t.Errorf("got account ID %q, want %q", got, want)
+		}
+	})
+
+	t.Run("replay_without_cassette", func(t *testing.T) {
+		t.Setenv(conns.EnvVarAccReplay, "1")
+
+		Provider = newProvider()
+		PreCheck(t)
+
+		t.Error("expected test to be skipped")
+	})
+}
@@ -0,0 +1,131 @@
+package acctest
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// fakeAWS is the in-process stand-in for AWS started by StartFakeAWS, if any.
+var fakeAWS *fakeaws.Server
+
+// StartFakeAWS starts an in-process stand-in for AWS for the duration of the test
+// and points the provider at it, replacing Provider with an instance configured
+// against the stand-in so that check functions can use Provider.Meta() as usual.
+//
+// The provider configuration comes from environment variables, so tests using the
+// stand-in must not call t.Parallel().
+func StartFakeAWS(t *testing.T) *fakeaws.Server {
+	t.Helper()
+
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv("AWS_ENDPOINT_URL", server.URL)
+	t.Setenv(conns.EnvVarAccessKeyId, fakeaws.AccessKeyID)
+	t.Setenv(conns.EnvVarSecretAccessKey, fakeaws.SecretAccessKey)
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	t.Setenv(conns.EnvVarProfile, "")
+	t.Setenv(conns.EnvVarContainerCredentialsFullUri, "")
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	t.Setenv(conns.EnvVarDefaultRegion, Region())
+	t.Setenv("AWS_REGION", Region())
+
+	p := newFakeAWSProvider()
+
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatalf("configuring provider for AWS stand-in: %v", diags)
+	}
+
+	previousProvider := Provider
+	Provider = p
+	fakeAWS = server
+
+	t.Cleanup(func() {
+		Provider = previousProvider
+		fakeAWS = nil
+	})
+
+	return server
+}
+
+// newFakeAWSProvider returns a new provider instance for use with the AWS stand-in.
+// S3 uses path-style addressing, as bucket subdomains of the stand-in's local endpoint don't resolve.
+// The EC2 platforms and account ID aren't looked up, and the account ID is the stand-in's.
+func newFakeAWSProvider() *schema.Provider {
+	p := provider.Provider()
+
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		for _, k := range []string{"s3_use_path_style", "skip_get_ec2_platforms", "skip_requesting_account_id"} {
+			if err := d.Set(k, true); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		meta, diags := configure(ctx, d)
+
+		if client, ok := meta.(*conns.AWSClient); ok {
+			client.AccountID = fakeaws.AccountID
+		}
+
+		return meta, diags
+	}
+
+	return p
+}
+
+// FakeAWSTest runs an acceptance test case against an in-process stand-in for AWS,
+// without AWS credentials and without the TF_ACC environment variable.
+// See the fakeaws package for the supported resources.
+//
+// A Terraform CLI is still required. The test is skipped if neither TF_ACC_TERRAFORM_PATH
+// nor TF_ACC_TERRAFORM_VERSION is set and no terraform binary is found in the PATH.
+func FakeAWSTest(t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("skipping test; Terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run tests against the AWS stand-in")
+		}
+	}
+
+	StartFakeAWS(t)
+
+	// Allocate new provider instances, which read the stand-in configuration from the environment.
+	names := []string{ProviderName}
+
+	if c.Providers != nil || c.ProviderFactories != nil {
+		names = nil
+
+		for name := range c.Providers {
+			names = append(names, name)
+		}
+
+		for name := range c.ProviderFactories {
+			names = append(names, name)
+		}
+	}
+
+	if c.ProtoV5ProviderFactories == nil {
+		factories := make(map[string]func() (*schema.Provider, error), len(names))
+
+		for _, name := range names {
+			factories[name] = func() (*schema.Provider, error) { return newFakeAWSProvider(), nil } //nolint:unparam
+		}
+
+		c.Providers = nil
+		c.ProviderFactories = factories
+	}
+
+	resource.UnitTest(t, c)
+}
@@ -0,0 +1,622 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	dynamoDBErrCodeResourceInUseException    = "ResourceInUseException"
+	dynamoDBErrCodeResourceNotFoundException = "ResourceNotFoundException"
+	dynamoDBErrCodeValidationException       = "ValidationException"
+)
+
+// dynamoDBTable is a DynamoDB table.
+// The table description is kept in its JSON form, as most of it is returned as it was specified on creation.
+type dynamoDBTable struct {
+	description map[string]interface{}
+	pitr        map[string]interface{}
+	ttl         map[string]interface{}
+	tags        map[string]string
+}
+
+type dynamoDBService struct {
+	mu     sync.Mutex
+	tables map[string]*dynamoDBTable // Keyed by table name.
+}
+
+func newDynamoDBService() *dynamoDBService {
+	return &dynamoDBService{
+		tables: make(map[string]*dynamoDBTable),
+	}
+}
+
+func (s *dynamoDBService) actions() jsonActions {
+	return jsonActions{
+		"CreateTable":               s.createTable,
+		"DeleteTable":               s.deleteTable,
+		"DescribeContinuousBackups": s.describeContinuousBackups,
+		"DescribeTable":             s.describeTable,
+		"DescribeTimeToLive":        s.describeTimeToLive,
+		"ListTables":                s.listTables,
+		"ListTagsOfResource":        s.listTagsOfResource,
+		"TagResource":               s.tagResource,
+		"UntagResource":             s.untagResource,
+		"UpdateContinuousBackups":   s.updateContinuousBackups,
+		"UpdateTable":               s.updateTable,
+		"UpdateTimeToLive":          s.updateTimeToLive,
+	}
+}
+
+type dynamoDBTag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+type dynamoDBTableInput struct {
+	ResourceARN string `json:"ResourceArn"`
+	TableName   string
+}
+
+func (s *dynamoDBService) table(name string) (*dynamoDBTable, error) {
+	table, ok := s.tables[name]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, dynamoDBErrCodeResourceNotFoundException, "Requested resource not found: Table: %s not found", name)
+	}
+
+	return table, nil
+}
+
+// tableByInput returns the table identified by the TableName or ResourceArn of the input.
+func (s *dynamoDBService) tableByInput(req *request) (*dynamoDBTable, error) {
+	var input dynamoDBTableInput
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	if input.ResourceARN == "" {
+		return s.table(input.TableName)
+	}
+
+	for _, table := range s.tables {
+		if table.description["TableArn"] == input.ResourceARN {
+			return table, nil
+		}
+	}
+
+	return nil, newError(http.StatusBadRequest, dynamoDBErrCodeResourceNotFoundException, "Requested resource not found: ResourceArn: %s not found", input.ResourceARN)
+}
+
+// dynamoDBProvisionedThroughputDescription returns the description of the specified provisioned throughput.
+func dynamoDBProvisionedThroughputDescription(v interface{}) map[string]interface{} {
+	description := map[string]interface{}{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		for _, k := range []string{"ReadCapacityUnits", "WriteCapacityUnits"} {
+			if v, ok := m[k]; ok {
+				description[k] = v
+			}
+		}
+	}
+
+	return description
+}
+
+func dynamoDBGlobalSecondaryIndexDescription(tableARN string, v interface{}) interface{} {
+	index, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	index["IndexArn"] = tableARN + "/index/" + index["IndexName"].(string)
+	index["IndexSizeBytes"] = 0
+	index["IndexStatus"] = "ACTIVE"
+	index["ItemCount"] = 0
+	index["ProvisionedThroughput"] = dynamoDBProvisionedThroughputDescription(index["ProvisionedThroughput"])
+
+	return index
+}
+
+// setStreamSpecification updates the table description for the specified stream specification.
+func (t *dynamoDBTable) setStreamSpecification(v interface{}) {
+	specification, ok := v.(map[string]interface{})
+
+	if !ok || specification["StreamEnabled"] != true {
+		delete(t.description, "StreamSpecification")
+
+		return
+	}
+
+	label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+
+	t.description["LatestStreamArn"] = t.description["TableArn"].(string) + "/stream/" + label
+	t.description["LatestStreamLabel"] = label
+	t.description["StreamSpecification"] = specification
+}
+
+// setSSESpecification updates the table description for the specified server-side encryption specification.
+func (t *dynamoDBTable) setSSESpecification(region string, v interface{}) {
+	specification, ok := v.(map[string]interface{})
+
+	if !ok || specification["Enabled"] != true {
+		delete(t.description, "SSEDescription")
+
+		return
+	}
+
+	keyARN := arn(region, "kms", "key/"+newID("", 36))
+
+	if v, ok := specification["KMSMasterKeyId"].(string); ok && v != "" {
+		keyARN = v
+	}
+
+	t.description["SSEDescription"] = map[string]interface{}{
+		"KMSMasterKeyArn": keyARN,
+		"SSEType":         "KMS",
+		"Status":          "ENABLED",
+	}
+}
+
+func (t *dynamoDBTable) setBillingMode(v interface{}) {
+	if billingMode, ok := v.(string); ok && billingMode != "" {
+		t.description["BillingModeSummary"] = map[string]interface{}{
+			"BillingMode":                       billingMode,
+			"LastUpdateToPayPerRequestDateTime": epochTime(time.Now()),
+		}
+	}
+}
+
+func (s *dynamoDBService) createTable(req *request) (interface{}, error) {
+	var input map[string]interface{}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, _ := input["TableName"].(string)
+
+	if _, ok := s.tables[name]; ok {
+		return nil, newError(http.StatusBadRequest, dynamoDBErrCodeResourceInUseException, "Table already exists: %s", name)
+	}
+
+	tableARN := arn(req.region, "dynamodb", "table/"+name)
+	table := &dynamoDBTable{
+		description: map[string]interface{}{
+			"AttributeDefinitions":  input["AttributeDefinitions"],
+			"CreationDateTime":      epochTime(time.Now()),
+			"ItemCount":             0,
+			"KeySchema":             input["KeySchema"],
+			"ProvisionedThroughput": dynamoDBProvisionedThroughputDescription(input["ProvisionedThroughput"]),
+			"TableArn":              tableARN,
+			"TableId":               newID("", 36),
+			"TableName":             name,
+			"TableSizeBytes":        0,
+			"TableStatus":           "ACTIVE",
+		},
+		pitr: map[string]interface{}{
+			"PointInTimeRecoveryStatus": "DISABLED",
+		},
+		ttl: map[string]interface{}{
+			"TimeToLiveStatus": "DISABLED",
+		},
+		tags: make(map[string]string),
+	}
+
+	table.setBillingMode(input["BillingMode"])
+	table.setStreamSpecification(input["StreamSpecification"])
+	table.setSSESpecification(req.region, input["SSESpecification"])
+
+	if v, ok := input["GlobalSecondaryIndexes"].([]interface{}); ok {
+		for _, index := range v {
+			dynamoDBGlobalSecondaryIndexDescription(tableARN, index)
+		}
+
+		table.description["GlobalSecondaryIndexes"] = v
+	}
+
+	if v, ok := input["LocalSecondaryIndexes"].([]interface{}); ok {
+		for _, v := range v {
+			if index, ok := v.(map[string]interface{}); ok {
+				index["IndexArn"] = tableARN + "/index/" + index["IndexName"].(string)
+				index["IndexSizeBytes"] = 0
+				index["ItemCount"] = 0
+			}
+		}
+
+		table.description["LocalSecondaryIndexes"] = v
+	}
+
+	if v, ok := input["TableClass"]; ok {
+		table.description["TableClassSummary"] = map[string]interface{}{
+			"TableClass": v,
+		}
+	}
+
+	if v, ok := input["Tags"].([]interface{}); ok {
+		for _, v := range v {
+			if tag, ok := v.(map[string]interface{}); ok {
+				table.tags[tag["Key"].(string)] = tag["Value"].(string)
+			}
+		}
+	}
+
+	s.tables[name] = table
+
+	return map[string]interface{}{
+		"TableDescription": table.description,
+	}, nil
+}
+
+func (s *dynamoDBService) deleteTable(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.tables, table.description["TableName"].(string))
+
+	description := make(map[string]interface{}, len(table.description))
+
+	for k, v := range table.description {
+		description[k] = v
+	}
+
+	description["TableStatus"] = "DELETING"
+
+	return map[string]interface{}{
+		"TableDescription": description,
+	}, nil
+}
+
+func (s *dynamoDBService) describeTable(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"Table": table.description,
+	}, nil
+}
+
+func (s *dynamoDBService) listTables(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := []string{}
+
+	for name := range s.tables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return map[string]interface{}{
+		"TableNames": names,
+	}, nil
+}
+
+func (s *dynamoDBService) updateTable(req *request) (interface{}, error) {
+	var input map[string]interface{}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, _ := input["TableName"].(string)
+	table, err := s.table(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := input["ReplicaUpdates"]; ok {
+		return nil, newError(http.StatusBadRequest, dynamoDBErrCodeValidationException, "Replicas are not implemented by the AWS stand-in")
+	}
+
+	if v, ok := input["AttributeDefinitions"]; ok {
+		table.description["AttributeDefinitions"] = v
+	}
+
+	if v, ok := input["BillingMode"]; ok {
+		table.setBillingMode(v)
+
+		if v == "PAY_PER_REQUEST" {
+			table.description["ProvisionedThroughput"] = dynamoDBProvisionedThroughputDescription(nil)
+		}
+	}
+
+	if v, ok := input["ProvisionedThroughput"]; ok {
+		table.description["ProvisionedThroughput"] = dynamoDBProvisionedThroughputDescription(v)
+	}
+
+	if v, ok := input["SSESpecification"]; ok {
+		table.setSSESpecification(req.region, v)
+	}
+
+	if v, ok := input["StreamSpecification"]; ok {
+		table.setStreamSpecification(v)
+	}
+
+	if v, ok := input["TableClass"]; ok {
+		table.description["TableClassSummary"] = map[string]interface{}{
+			"TableClass": v,
+		}
+	}
+
+	if v, ok := input["GlobalSecondaryIndexUpdates"].([]interface{}); ok {
+		if err := table.updateGlobalSecondaryIndexes(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"TableDescription": table.description,
+	}, nil
+}
+
+// updateGlobalSecondaryIndexes applies the Create, Update and Delete global secondary index updates.
+func (t *dynamoDBTable) updateGlobalSecondaryIndexes(updates []interface{}) error {
+	tableARN := t.description["TableArn"].(string)
+	indexes, _ := t.description["GlobalSecondaryIndexes"].([]interface{})
+
+	for _, v := range updates {
+		update, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := update["Create"]; ok {
+			indexes = append(indexes, dynamoDBGlobalSecondaryIndexDescription(tableARN, v))
+
+			continue
+		}
+
+		for _, operation := range []string{"Update", "Delete"} {
+			v, ok := update[operation].(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			found := false
+
+			for i, index := range indexes {
+				index := index.(map[string]interface{})
+
+				if index["IndexName"] != v["IndexName"] {
+					continue
+				}
+
+				found = true
+
+				if operation == "Delete" {
+					indexes = append(indexes[:i], indexes[i+1:]...)
+				} else {
+					index["ProvisionedThroughput"] = dynamoDBProvisionedThroughputDescription(v["ProvisionedThroughput"])
+				}
+
+				break
+			}
+
+			if !found {
+				return newError(http.StatusBadRequest, dynamoDBErrCodeResourceNotFoundException, "Requested resource not found: Index: %v not found", v["IndexName"])
+			}
+		}
+	}
+
+	if len(indexes) == 0 {
+		delete(t.description, "GlobalSecondaryIndexes")
+	} else {
+		t.description["GlobalSecondaryIndexes"] = indexes
+	}
+
+	return nil
+}
+
+func (s *dynamoDBService) describeContinuousBackups(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return table.continuousBackupsOutput(), nil
+}
+
+func (t *dynamoDBTable) continuousBackupsOutput() map[string]interface{} {
+	return map[string]interface{}{
+		"ContinuousBackupsDescription": map[string]interface{}{
+			"ContinuousBackupsStatus":        "ENABLED",
+			"PointInTimeRecoveryDescription": t.pitr,
+		},
+	}
+}
+
+func (s *dynamoDBService) updateContinuousBackups(req *request) (interface{}, error) {
+	var input struct {
+		PointInTimeRecoverySpecification struct {
+			PointInTimeRecoveryEnabled bool
+		}
+		TableName string
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.table(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if input.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled {
+		now := epochTime(time.Now())
+		table.pitr = map[string]interface{}{
+			"EarliestRestorableDateTime": now,
+			"LatestRestorableDateTime":   now,
+			"PointInTimeRecoveryStatus":  "ENABLED",
+		}
+	} else {
+		table.pitr = map[string]interface{}{
+			"PointInTimeRecoveryStatus": "DISABLED",
+		}
+	}
+
+	return table.continuousBackupsOutput(), nil
+}
+
+func (s *dynamoDBService) describeTimeToLive(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"TimeToLiveDescription": table.ttl,
+	}, nil
+}
+
+func (s *dynamoDBService) updateTimeToLive(req *request) (interface{}, error) {
+	var input struct {
+		TableName               string
+		TimeToLiveSpecification struct {
+			AttributeName string
+			Enabled       bool
+		}
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.table(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if input.TimeToLiveSpecification.Enabled {
+		table.ttl = map[string]interface{}{
+			"AttributeName":    input.TimeToLiveSpecification.AttributeName,
+			"TimeToLiveStatus": "ENABLED",
+		}
+	} else {
+		table.ttl = map[string]interface{}{
+			"TimeToLiveStatus": "DISABLED",
+		}
+	}
+
+	return map[string]interface{}{
+		"TimeToLiveSpecification": input.TimeToLiveSpecification,
+	}, nil
+}
+
+func (s *dynamoDBService) listTagsOfResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []dynamoDBTag{}
+
+	for _, k := range sortedKeys(table.tags) {
+		tags = append(tags, dynamoDBTag{Key: k, Value: table.tags[k]})
+	}
+
+	return map[string]interface{}{
+		"Tags": tags,
+	}, nil
+}
+
+func (s *dynamoDBService) tagResource(req *request) (interface{}, error) {
+	var input struct {
+		Tags []dynamoDBTag
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		table.tags[tag.Key] = tag.Value
+	}
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) untagResource(req *request) (interface{}, error) {
+	var input struct {
+		TagKeys []string
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	table, err := s.tableByInput(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range input.TagKeys {
+		delete(table.tags, k)
+	}
+
+	return nil, nil
+}
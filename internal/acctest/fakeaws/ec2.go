@@ -0,0 +1,32 @@
+package fakeaws
+
+type ec2Service struct{}
+
+func newEC2Service() *ec2Service {
+	return &ec2Service{}
+}
+
+func (s *ec2Service) actions() ec2Actions {
+	return ec2Actions{
+		"DescribeAccountAttributes": s.describeAccountAttributes,
+	}
+}
+
+type ec2AccountAttribute struct {
+	AttributeName   string   `xml:"attributeName"`
+	AttributeValues []string `xml:"attributeValueSet>item>attributeValue"`
+}
+
+type ec2DescribeAccountAttributesResult struct {
+	AccountAttributes []ec2AccountAttribute `xml:"accountAttributeSet>item"`
+}
+
+// describeAccountAttributes returns the supported platforms, EC2-VPC only, whatever attributes are requested.
+func (s *ec2Service) describeAccountAttributes(req *request) (interface{}, error) {
+	return &ec2DescribeAccountAttributesResult{
+		AccountAttributes: []ec2AccountAttribute{{
+			AttributeName:   "supported-platforms",
+			AttributeValues: []string{"VPC"},
+		}},
+	}, nil
+}
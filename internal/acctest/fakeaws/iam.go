@@ -0,0 +1,523 @@
+package fakeaws
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	iamErrCodeDeleteConflict      = "DeleteConflict"
+	iamErrCodeEntityAlreadyExists = "EntityAlreadyExists"
+	iamErrCodeNoSuchEntity        = "NoSuchEntity"
+)
+
+type iamTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryARN  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+type iamRole struct {
+	ARN                      string                  `xml:"Arn"`
+	AssumeRolePolicyDocument string                  `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string                  `xml:"CreateDate"`
+	Description              string                  `xml:"Description,omitempty"`
+	MaxSessionDuration       int64                   `xml:"MaxSessionDuration"`
+	Path                     string                  `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundary `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                  `xml:"RoleId"`
+	RoleName                 string                  `xml:"RoleName"`
+	Tags                     []iamTag                `xml:"Tags>member,omitempty"`
+
+	attachedPolicies map[string]string // Policy name keyed by policy ARN.
+	policies         map[string]string // Policy document keyed by policy name.
+	tags             map[string]string
+}
+
+type iamService struct {
+	mu    sync.Mutex
+	roles map[string]*iamRole // Keyed by role name.
+}
+
+func newIAMService() *iamService {
+	return &iamService{
+		roles: make(map[string]*iamRole),
+	}
+}
+
+func (s *iamService) actions() queryActions {
+	return queryActions{
+		"AttachRolePolicy":              s.attachRolePolicy,
+		"CreateRole":                    s.createRole,
+		"DeleteRole":                    s.deleteRole,
+		"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              s.deleteRolePolicy,
+		"DetachRolePolicy":              s.detachRolePolicy,
+		"GetRole":                       s.getRole,
+		"GetRolePolicy":                 s.getRolePolicy,
+		"ListAttachedRolePolicies":      s.listAttachedRolePolicies,
+		"ListInstanceProfilesForRole":   s.listInstanceProfilesForRole,
+		"ListRolePolicies":              s.listRolePolicies,
+		"ListRoleTags":                  s.listRoleTags,
+		"ListRoles":                     s.listRoles,
+		"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+		"PutRolePolicy":                 s.putRolePolicy,
+		"TagRole":                       s.tagRole,
+		"UntagRole":                     s.untagRole,
+		"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+		"UpdateRole":                    s.updateRole,
+		"UpdateRoleDescription":         s.updateRoleDescription,
+	}
+}
+
+type iamRoleResult struct {
+	Role *iamRole `xml:"Role"`
+}
+
+type iamAttachedPolicy struct {
+	PolicyARN  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+type iamListAttachedRolePoliciesResult struct {
+	AttachedPolicies []iamAttachedPolicy `xml:"AttachedPolicies>member"`
+	IsTruncated      bool                `xml:"IsTruncated"`
+}
+
+type iamListInstanceProfilesForRoleResult struct {
+	InstanceProfiles struct{} `xml:"InstanceProfiles"`
+	IsTruncated      bool     `xml:"IsTruncated"`
+}
+
+type iamListRolePoliciesResult struct {
+	IsTruncated bool     `xml:"IsTruncated"`
+	PolicyNames []string `xml:"PolicyNames>member"`
+}
+
+type iamListRoleTagsResult struct {
+	IsTruncated bool     `xml:"IsTruncated"`
+	Tags        []iamTag `xml:"Tags>member"`
+}
+
+type iamListRolesResult struct {
+	IsTruncated bool       `xml:"IsTruncated"`
+	Roles       []*iamRole `xml:"Roles>member"`
+}
+
+type iamGetRolePolicyResult struct {
+	PolicyDocument string `xml:"PolicyDocument"`
+	PolicyName     string `xml:"PolicyName"`
+	RoleName       string `xml:"RoleName"`
+}
+
+func (s *iamService) role(req *request) (*iamRole, error) {
+	name := req.params.Get("RoleName")
+	role, ok := s.roles[name]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, iamErrCodeNoSuchEntity, "The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+// output returns a copy of the role for serialization.
+func (r *iamRole) output() *iamRole {
+	output := *r
+	output.Tags = nil
+
+	for _, k := range sortedKeys(r.tags) {
+		output.Tags = append(output.Tags, iamTag{Key: k, Value: r.tags[k]})
+	}
+
+	return &output
+}
+
+func (s *iamService) createRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := req.params.Get("RoleName")
+
+	if _, ok := s.roles[name]; ok {
+		return nil, newError(http.StatusConflict, iamErrCodeEntityAlreadyExists, "Role with name %s already exists.", name)
+	}
+
+	rolePath := req.params.Get("Path")
+
+	if rolePath == "" {
+		rolePath = "/"
+	}
+
+	role := &iamRole{
+		ARN:                      arn("", "iam", "role"+path.Join(rolePath, name)),
+		AssumeRolePolicyDocument: url.QueryEscape(req.params.Get("AssumeRolePolicyDocument")),
+		CreateDate:               iso8601Time(time.Now()),
+		Description:              req.params.Get("Description"),
+		MaxSessionDuration:       3600,
+		Path:                     rolePath,
+		RoleID:                   newID("AROA", 21),
+		RoleName:                 name,
+
+		attachedPolicies: make(map[string]string),
+		policies:         make(map[string]string),
+		tags:             req.keyValues("Tags", "Key", "Value"),
+	}
+
+	if v := req.params.Get("MaxSessionDuration"); v != "" {
+		role.MaxSessionDuration, _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	if v := req.params.Get("PermissionsBoundary"); v != "" {
+		role.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryARN:  v,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	s.roles[name] = role
+
+	return &iamRoleResult{Role: role.output()}, nil
+}
+
+func (s *iamService) deleteRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.attachedPolicies) > 0 || len(role.policies) > 0 {
+		return nil, newError(http.StatusConflict, iamErrCodeDeleteConflict, "Cannot delete entity, must detach all policies first.")
+	}
+
+	delete(s.roles, role.RoleName)
+
+	return nil, nil
+}
+
+func (s *iamService) getRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iamRoleResult{Role: role.output()}, nil
+}
+
+func (s *iamService) listRoles(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pathPrefix := req.params.Get("PathPrefix")
+	result := &iamListRolesResult{}
+
+	for _, role := range s.roles {
+		if strings.HasPrefix(role.Path, pathPrefix) {
+			result.Roles = append(result.Roles, role.output())
+		}
+	}
+
+	sort.Slice(result.Roles, func(i, j int) bool {
+		return result.Roles[i].RoleName < result.Roles[j].RoleName
+	})
+
+	return result, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.AssumeRolePolicyDocument = url.QueryEscape(req.params.Get("PolicyDocument"))
+
+	return nil, nil
+}
+
+func (s *iamService) updateRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := req.params["Description"]; ok {
+		role.Description = v[0]
+	}
+
+	if v := req.params.Get("MaxSessionDuration"); v != "" {
+		role.MaxSessionDuration, _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateRoleDescription(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.Description = req.params.Get("Description")
+
+	return &iamRoleResult{Role: role.output()}, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.PermissionsBoundary = &iamPermissionsBoundary{
+		PermissionsBoundaryARN:  req.params.Get("PermissionsBoundary"),
+		PermissionsBoundaryType: "Policy",
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.PermissionsBoundary = nil
+
+	return nil, nil
+}
+
+func (s *iamService) listRoleTags(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &iamListRoleTagsResult{Tags: role.output().Tags}, nil
+}
+
+func (s *iamService) tagRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range req.keyValues("Tags", "Key", "Value") {
+		role.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range req.list("TagKeys") {
+		delete(role.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.policies[req.params.Get("PolicyName")] = req.params.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) getRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.params.Get("PolicyName")
+	document, ok := role.policies[name]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, iamErrCodeNoSuchEntity, "The role policy with name %s cannot be found.", name)
+	}
+
+	return &iamGetRolePolicyResult{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     name,
+		RoleName:       role.RoleName,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.params.Get("PolicyName")
+
+	if _, ok := role.policies[name]; !ok {
+		return nil, newError(http.StatusNotFound, iamErrCodeNoSuchEntity, "The role policy with name %s cannot be found.", name)
+	}
+
+	delete(role.policies, name)
+
+	return nil, nil
+}
+
+func (s *iamService) listRolePolicies(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &iamListRolePoliciesResult{}
+
+	for _, name := range sortedKeys(role.policies) {
+		result.PolicyNames = append(result.PolicyNames, name)
+	}
+
+	return result, nil
+}
+
+func (s *iamService) attachRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := req.params.Get("PolicyArn")
+	role.attachedPolicies[policyARN] = path.Base(policyARN)
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := req.params.Get("PolicyArn")
+
+	if _, ok := role.attachedPolicies[policyARN]; !ok {
+		return nil, newError(http.StatusNotFound, iamErrCodeNoSuchEntity, "Policy %s was not found.", policyARN)
+	}
+
+	delete(role.attachedPolicies, policyARN)
+
+	return nil, nil
+}
+
+func (s *iamService) listAttachedRolePolicies(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &iamListAttachedRolePoliciesResult{}
+
+	for _, policyARN := range sortedKeys(role.attachedPolicies) {
+		result.AttachedPolicies = append(result.AttachedPolicies, iamAttachedPolicy{
+			PolicyARN:  policyARN,
+			PolicyName: role.attachedPolicies[policyARN],
+		})
+	}
+
+	return result, nil
+}
+
+func (s *iamService) listInstanceProfilesForRole(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.role(req); err != nil {
+		return nil, err
+	}
+
+	return &iamListInstanceProfilesForRoleResult{}, nil
+}
@@ -0,0 +1,367 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// apiError is an AWS API error returned by the stand-in.
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func newError(statusCode int, code, format string, a ...interface{}) *apiError {
+	return &apiError{
+		statusCode: statusCode,
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+	}
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func toAPIError(err error) *apiError {
+	if e, ok := err.(*apiError); ok {
+		return e
+	}
+
+	return newError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// request is an API request to a service.
+type request struct {
+	*http.Request
+
+	region string
+	params url.Values // AWS Query protocol request parameters.
+	body   []byte     // AWS JSON protocol request body.
+}
+
+// action handles a single API operation, returning the result to serialize.
+type action func(req *request) (interface{}, error)
+
+// queryActions are the AWS Query protocol operations of a service, keyed by action name.
+type queryActions map[string]action
+
+func (a queryActions) handle(w http.ResponseWriter, r *http.Request, region string) {
+	if err := r.ParseForm(); err != nil {
+		writeXMLError(w, r, newError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	name := r.Form.Get("Action")
+	f, ok := a[name]
+
+	if !ok {
+		writeXMLError(w, r, newError(http.StatusBadRequest, "InvalidAction", "The action %s is not valid for this web service", name))
+		return
+	}
+
+	result, err := f(&request{Request: r, region: region, params: r.Form})
+
+	if err != nil {
+		writeXMLError(w, r, err)
+		return
+	}
+
+	writeQueryResult(w, name, result)
+}
+
+// list returns the members of a list parameter, e.g. TagKeys.member.1, TagKeys.member.2.
+// Flattened lists, e.g. AttributeName.1, are also supported.
+func (r *request) list(name string) []string {
+	var result []string
+
+	for _, format := range []string{"%s.member.%d", "%s.%d"} {
+		for i := 1; ; i++ {
+			v, ok := r.params[fmt.Sprintf(format, name, i)]
+
+			if !ok {
+				break
+			}
+
+			result = append(result, v[0])
+		}
+	}
+
+	return result
+}
+
+// keyValues returns the entries of a map or key-value list parameter, e.g.
+//
+//	Attributes.entry.1.key and Attributes.entry.1.value (map),
+//	Tags.member.1.Key and Tags.member.1.Value (list of structures),
+//	Attribute.1.Name and Attribute.1.Value (flattened map).
+func (r *request) keyValues(name, keyName, valueName string) map[string]string {
+	result := make(map[string]string)
+
+	for _, format := range []string{"%s.entry.%d.", "%s.member.%d.", "%s.%d."} {
+		for i := 1; ; i++ {
+			prefix := fmt.Sprintf(format, name, i)
+			k, ok := r.params[prefix+keyName]
+
+			if !ok {
+				break
+			}
+
+			result[k[0]] = r.params.Get(prefix + valueName)
+		}
+	}
+
+	return result
+}
+
+type xmlErrorResponse struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Error     xmlError `xml:"Error"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type xmlError struct {
+	Type    string `xml:"Type"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func writeXMLError(w http.ResponseWriter, r *http.Request, err error) {
+	e := toAPIError(err)
+	errorType := "Sender"
+
+	if e.statusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	writeXML(w, r, e.statusCode, xmlErrorResponse{
+		Error: xmlError{
+			Type:    errorType,
+			Code:    e.code,
+			Message: e.message,
+		},
+		RequestID: newRequestID(),
+	})
+}
+
+type xmlResponseMetadata struct {
+	RequestID string `xml:"RequestId"`
+}
+
+// writeQueryResult writes an AWS Query protocol response, e.g.
+//
+//	<CreateQueueResponse><CreateQueueResult>...</CreateQueueResult><ResponseMetadata>...</ResponseMetadata></CreateQueueResponse>
+func writeQueryResult(w http.ResponseWriter, name string, result interface{}) {
+	if result == nil {
+		result = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(http.StatusOK)
+
+	io.WriteString(w, xml.Header)
+	fmt.Fprintf(w, "<%sResponse>", name)
+
+	enc := xml.NewEncoder(w)
+	enc.EncodeElement(result, xml.StartElement{Name: xml.Name{Local: name + "Result"}})
+	enc.EncodeElement(xmlResponseMetadata{RequestID: newRequestID()}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}})
+	enc.Flush()
+
+	fmt.Fprintf(w, "</%sResponse>", name)
+}
+
+// ec2Actions are the EC2 Query protocol operations, keyed by action name.
+// The EC2 Query protocol differs from the AWS Query protocol in the shape of its responses and errors.
+type ec2Actions map[string]action
+
+func (a ec2Actions) handle(w http.ResponseWriter, r *http.Request, region string) {
+	if err := r.ParseForm(); err != nil {
+		writeEC2Error(w, r, newError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	name := r.Form.Get("Action")
+	f, ok := a[name]
+
+	if !ok {
+		writeEC2Error(w, r, newError(http.StatusBadRequest, "InvalidAction", "The action %s is not valid for this web service", name))
+		return
+	}
+
+	result, err := f(&request{Request: r, region: region, params: r.Form})
+
+	if err != nil {
+		writeEC2Error(w, r, err)
+		return
+	}
+
+	writeEC2Result(w, name, result)
+}
+
+type ec2ErrorResponse struct {
+	XMLName   xml.Name   `xml:"Response"`
+	Errors    []xmlError `xml:"Errors>Error"`
+	RequestID string     `xml:"RequestID"`
+}
+
+func writeEC2Error(w http.ResponseWriter, r *http.Request, err error) {
+	e := toAPIError(err)
+
+	writeXML(w, r, e.statusCode, ec2ErrorResponse{
+		Errors: []xmlError{{
+			Code:    e.code,
+			Message: e.message,
+		}},
+		RequestID: newRequestID(),
+	})
+}
+
+// writeEC2Result writes an EC2 Query protocol response, e.g.
+//
+//	<DescribeAccountAttributesResponse>...</DescribeAccountAttributesResponse>
+//
+// The result's fields are the response element's children. The request ID is returned in a header.
+func writeEC2Result(w http.ResponseWriter, name string, result interface{}) {
+	if result == nil {
+		result = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.Header().Set("X-Amzn-Requestid", newRequestID())
+	w.WriteHeader(http.StatusOK)
+
+	io.WriteString(w, xml.Header)
+
+	enc := xml.NewEncoder(w)
+	enc.EncodeElement(result, xml.StartElement{Name: xml.Name{Local: name + "Response"}})
+	enc.Flush()
+}
+
+func writeXML(w http.ResponseWriter, r *http.Request, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(v)
+}
+
+// jsonActions are the AWS JSON protocol operations of a service, keyed by operation name.
+type jsonActions map[string]action
+
+// handle returns a handler for operations whose X-Amz-Target header has the given prefix, e.g. DynamoDB_20120810.CreateTable.
+func (a jsonActions) handle(targetPrefix string) handler {
+	return func(w http.ResponseWriter, r *http.Request, region string) {
+		name := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), targetPrefix+".")
+		f, ok := a[name]
+
+		if !ok {
+			writeJSONError(w, newError(http.StatusBadRequest, "UnknownOperationException", "Unknown operation %s", name))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			writeJSONError(w, err)
+			return
+		}
+
+		result, err := f(&request{Request: r, region: region, body: body})
+
+		if err != nil {
+			writeJSONError(w, err)
+			return
+		}
+
+		if result == nil {
+			result = struct{}{}
+		}
+
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// decode decodes the JSON request body into v.
+func (r *request) decode(v interface{}) error {
+	if err := json.Unmarshal(r.body, v); err != nil {
+		return newError(http.StatusBadRequest, "SerializationException", "%s", err)
+	}
+
+	return nil
+}
+
+func writeJSONError(w http.ResponseWriter, err error) {
+	e := toAPIError(err)
+
+	writeJSON(w, e.statusCode, map[string]string{
+		"__type":  e.code,
+		"message": e.message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(v)
+}
+
+// arn returns an Amazon Resource Name in the stand-in's account.
+func arn(region, service, resource string) string {
+	return fmt.Sprintf("arn:aws:%s:%s:%s:%s", service, region, AccountID, resource)
+}
+
+// epochTime returns the time as fractional seconds since the epoch, the AWS JSON protocol timestamp format.
+func epochTime(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// iso8601Time returns the time in the AWS Query protocol timestamp format.
+func iso8601Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// copyMap returns a shallow copy of the map.
+func copyMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+
+	for k, v := range m {
+		result[k] = v
+	}
+
+	return result
+}
+
+var idCount uint64
+
+// newID returns a unique identifier with the given prefix, e.g. AROA for an IAM role.
+func newID(prefix string, length int) string {
+	return fmt.Sprintf("%s%0*d", prefix, length-len(prefix), atomic.AddUint64(&idCount, 1))
+}
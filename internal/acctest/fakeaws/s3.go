@@ -0,0 +1,330 @@
+package fakeaws
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	s3ErrCodeBucketAlreadyOwnedByYou = "BucketAlreadyOwnedByYou"
+	s3ErrCodeMethodNotAllowed        = "MethodNotAllowed"
+	s3ErrCodeNoSuchBucket            = "NoSuchBucket"
+	s3ErrCodeNotImplemented          = "NotImplemented"
+
+	s3CanonicalUserID = "79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be"
+	s3Namespace       = "http://s3.amazonaws.com/doc/2006-03-01/"
+)
+
+// s3BucketConfiguration is a bucket configuration subresource, e.g. ?versioning.
+// Configuration documents are stored as sent and returned as is; an unset configuration
+// returns either a default document or a not found error.
+type s3BucketConfiguration struct {
+	defaultDocument string
+	notFoundCode    string
+	notFoundMessage string
+}
+
+var s3BucketConfigurations = map[string]s3BucketConfiguration{
+	"accelerate": {
+		defaultDocument: `<AccelerateConfiguration xmlns="` + s3Namespace + `"/>`,
+	},
+	"acl": {
+		defaultDocument: `<AccessControlPolicy xmlns="` + s3Namespace + `"><Owner><ID>` + s3CanonicalUserID + `</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + s3CanonicalUserID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`,
+	},
+	"cors": {
+		notFoundCode:    "NoSuchCORSConfiguration",
+		notFoundMessage: "The CORS configuration does not exist",
+	},
+	"encryption": {
+		notFoundCode:    "ServerSideEncryptionConfigurationNotFoundError",
+		notFoundMessage: "The server side encryption configuration was not found",
+	},
+	"lifecycle": {
+		notFoundCode:    "NoSuchLifecycleConfiguration",
+		notFoundMessage: "The lifecycle configuration does not exist",
+	},
+	"logging": {
+		defaultDocument: `<BucketLoggingStatus xmlns="` + s3Namespace + `"/>`,
+	},
+	"object-lock": {
+		notFoundCode:    "ObjectLockConfigurationNotFoundError",
+		notFoundMessage: "Object Lock configuration does not exist for this bucket",
+	},
+	"ownershipControls": {
+		notFoundCode:    "OwnershipControlsNotFoundError",
+		notFoundMessage: "The bucket ownership controls were not found",
+	},
+	"policy": {
+		notFoundCode:    "NoSuchBucketPolicy",
+		notFoundMessage: "The bucket policy does not exist",
+	},
+	"publicAccessBlock": {
+		notFoundCode:    "NoSuchPublicAccessBlockConfiguration",
+		notFoundMessage: "The public access block configuration was not found",
+	},
+	"replication": {
+		notFoundCode:    "ReplicationConfigurationNotFoundError",
+		notFoundMessage: "The replication configuration was not found",
+	},
+	"requestPayment": {
+		defaultDocument: `<RequestPaymentConfiguration xmlns="` + s3Namespace + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	},
+	"tagging": {
+		notFoundCode:    "NoSuchTagSet",
+		notFoundMessage: "The TagSet does not exist",
+	},
+	"versioning": {
+		defaultDocument: `<VersioningConfiguration xmlns="` + s3Namespace + `"/>`,
+	},
+	"website": {
+		notFoundCode:    "NoSuchWebsiteConfiguration",
+		notFoundMessage: "The specified bucket does not have a website configuration",
+	},
+}
+
+type s3Bucket struct {
+	configurations map[string][]byte // Configuration documents keyed by subresource.
+	creationDate   time.Time
+	region         string
+}
+
+type s3Service struct {
+	mu      sync.Mutex
+	buckets map[string]*s3Bucket // Keyed by bucket name.
+}
+
+func newS3Service() *s3Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+type s3Error struct {
+	XMLName    xml.Name `xml:"Error"`
+	BucketName string   `xml:"BucketName,omitempty"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	RequestID  string   `xml:"RequestId"`
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, bucketName string, err error) {
+	e := toAPIError(err)
+
+	writeXML(w, r, e.statusCode, s3Error{
+		BucketName: bucketName,
+		Code:       e.code,
+		Message:    e.message,
+		RequestID:  newRequestID(),
+	})
+}
+
+// handle routes path-style S3 requests, e.g. PUT /bucket?versioning.
+func (s *s3Service) handle(w http.ResponseWriter, r *http.Request, region string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("X-Amz-Request-Id", newRequestID())
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucketName := parts[0]
+
+	if bucketName == "" {
+		if r.Method != http.MethodGet {
+			writeS3Error(w, r, "", newError(http.StatusMethodNotAllowed, s3ErrCodeMethodNotAllowed, "The specified method is not allowed against this resource."))
+			return
+		}
+
+		s.listBuckets(w, r)
+
+		return
+	}
+
+	if len(parts) > 1 && parts[1] != "" {
+		writeS3Error(w, r, bucketName, newError(http.StatusNotImplemented, s3ErrCodeNotImplemented, "Object operations are not implemented by the AWS stand-in"))
+		return
+	}
+
+	if r.Method == http.MethodPut && len(r.URL.Query()) == 0 {
+		s.createBucket(w, r, bucketName)
+		return
+	}
+
+	bucket, ok := s.buckets[bucketName]
+
+	if !ok {
+		writeS3Error(w, r, bucketName, newError(http.StatusNotFound, s3ErrCodeNoSuchBucket, "The specified bucket does not exist"))
+		return
+	}
+
+	query := r.URL.Query()
+
+	for subresource, configuration := range s3BucketConfigurations {
+		if _, ok := query[subresource]; ok {
+			s.handleConfiguration(w, r, bucket, subresource, configuration)
+			return
+		}
+	}
+
+	switch _, location := query["location"]; {
+	case location && r.Method == http.MethodGet:
+		locationConstraint := bucket.region
+
+		if locationConstraint == "us-east-1" {
+			locationConstraint = ""
+		}
+
+		writeXML(w, r, http.StatusOK, struct {
+			XMLName            xml.Name `xml:"LocationConstraint"`
+			Namespace          string   `xml:"xmlns,attr"`
+			LocationConstraint string   `xml:",chardata"`
+		}{Namespace: s3Namespace, LocationConstraint: locationConstraint})
+	case r.Method == http.MethodHead && len(query) == 0:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete && len(query) == 0:
+		delete(s.buckets, bucketName)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet:
+		s.listObjects(w, r, bucketName)
+	default:
+		writeS3Error(w, r, bucketName, newError(http.StatusNotImplemented, s3ErrCodeNotImplemented, "The operation is not implemented by the AWS stand-in"))
+	}
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *http.Request, bucketName string) {
+	if _, ok := s.buckets[bucketName]; ok {
+		writeS3Error(w, r, bucketName, newError(http.StatusConflict, s3ErrCodeBucketAlreadyOwnedByYou, "Your previous request to create the named bucket succeeded and you already own it."))
+		return
+	}
+
+	var input struct {
+		LocationConstraint string `xml:"LocationConstraint"`
+	}
+
+	if body, err := io.ReadAll(r.Body); err == nil && len(body) > 0 {
+		if err := xml.Unmarshal(body, &input); err != nil {
+			writeS3Error(w, r, bucketName, newError(http.StatusBadRequest, "MalformedXML", "%s", err))
+			return
+		}
+	}
+
+	bucket := &s3Bucket{
+		configurations: make(map[string][]byte),
+		creationDate:   time.Now(),
+		region:         input.LocationConstraint,
+	}
+
+	if bucket.region == "" {
+		bucket.region = "us-east-1"
+	}
+
+	if strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true") {
+		bucket.configurations["object-lock"] = []byte(`<ObjectLockConfiguration xmlns="` + s3Namespace + `"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+	}
+
+	s.buckets[bucketName] = bucket
+
+	w.Header().Set("Location", "/"+bucketName)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *s3Service) handleConfiguration(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, subresource string, configuration s3BucketConfiguration) {
+	switch r.Method {
+	case http.MethodGet:
+		document, ok := bucket.configurations[subresource]
+
+		if !ok && configuration.notFoundCode != "" {
+			writeS3Error(w, r, "", newError(http.StatusNotFound, configuration.notFoundCode, "%s", configuration.notFoundMessage))
+			return
+		}
+
+		if !ok {
+			document = []byte(configuration.defaultDocument)
+		}
+
+		if subresource == "policy" {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/xml")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(document)
+	case http.MethodPut:
+		document, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			writeS3Error(w, r, "", err)
+			return
+		}
+
+		// Canned ACLs are sent as a header, leaving the owner with full control.
+		if len(document) > 0 {
+			bucket.configurations[subresource] = document
+		}
+
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(bucket.configurations, subresource)
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, r, "", newError(http.StatusMethodNotAllowed, s3ErrCodeMethodNotAllowed, "The specified method is not allowed against this resource."))
+	}
+}
+
+type s3Owner struct {
+	ID string `xml:"ID"`
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter, r *http.Request) {
+	type bucket struct {
+		CreationDate string `xml:"CreationDate"`
+		Name         string `xml:"Name"`
+	}
+
+	result := struct {
+		XMLName   xml.Name `xml:"ListAllMyBucketsResult"`
+		Namespace string   `xml:"xmlns,attr"`
+		Buckets   []bucket `xml:"Buckets>Bucket"`
+		Owner     s3Owner  `xml:"Owner"`
+	}{
+		Namespace: s3Namespace,
+		Owner:     s3Owner{ID: s3CanonicalUserID},
+	}
+
+	for name, b := range s.buckets {
+		result.Buckets = append(result.Buckets, bucket{CreationDate: iso8601Time(b.creationDate), Name: name})
+	}
+
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].Name < result.Buckets[j].Name
+	})
+
+	writeXML(w, r, http.StatusOK, result)
+}
+
+// listObjects lists the objects or object versions in a bucket, which are always empty.
+func (s *s3Service) listObjects(w http.ResponseWriter, r *http.Request, bucketName string) {
+	name := "ListBucketResult"
+
+	if _, ok := r.URL.Query()["versions"]; ok {
+		name = "ListVersionsResult"
+	}
+
+	writeXML(w, r, http.StatusOK, struct {
+		XMLName     xml.Name
+		Namespace   string `xml:"xmlns,attr"`
+		IsTruncated bool   `xml:"IsTruncated"`
+		Name        string `xml:"Name"`
+	}{
+		XMLName:   xml.Name{Local: name},
+		Namespace: s3Namespace,
+		Name:      bucketName,
+	})
+}
@@ -0,0 +1,526 @@
+package fakeaws_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+)
+
+func newSession(t *testing.T) *session.Session {
+	t.Helper()
+
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(fakeaws.AccessKeyID, fakeaws.SecretAccessKey, ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-west-2"),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestServerUnknownService(t *testing.T) {
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, nil)
+
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+fakeaws.AccessKeyID+"/20220101/us-west-2/unknown/aws4_request, SignedHeaders=host, Signature=0")
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		t.Fatalf("sending request: %s", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		t.Fatalf("reading response: %s", err)
+	}
+
+	if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+		t.Errorf("got status code %d, want %d", got, want)
+	}
+
+	if !strings.Contains(string(body), "<Code>UnrecognizedClientException</Code>") {
+		t.Errorf("expected UnrecognizedClientException error, got: %s", body)
+	}
+}
+
+func TestEC2(t *testing.T) {
+	conn := ec2.New(newSession(t))
+
+	output, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
+		AttributeNames: aws.StringSlice([]string{ec2.AccountAttributeNameSupportedPlatforms}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.AccountAttributes), 1; got != want {
+		t.Fatalf("got %d account attributes, want %d", got, want)
+	}
+
+	attribute := output.AccountAttributes[0]
+
+	if got, want := aws.StringValue(attribute.AttributeName), ec2.AccountAttributeNameSupportedPlatforms; got != want {
+		t.Errorf("got attribute name %q, want %q", got, want)
+	}
+
+	if got, want := len(attribute.AttributeValues), 1; got != want {
+		t.Fatalf("got %d attribute values, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(attribute.AttributeValues[0].AttributeValue), "VPC"; got != want {
+		t.Errorf("got attribute value %q, want %q", got, want)
+	}
+
+	_, err = conn.DescribeRegions(&ec2.DescribeRegionsInput{})
+
+	if !tfawserr.ErrCodeEquals(err, "InvalidAction") {
+		t.Errorf("expected InvalidAction error, got: %s", err)
+	}
+}
+
+func TestSTS(t *testing.T) {
+	conn := sts.New(newSession(t))
+
+	output, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Account), fakeaws.AccountID; got != want {
+		t.Errorf("got account ID %q, want %q", got, want)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	conn := sqs.New(newSession(t))
+
+	createOutput, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameDelaySeconds: "10"}),
+		QueueName:  aws.String("test"),
+		Tags:       aws.StringMap(map[string]string{"Name": "test"}),
+	})
+
+	if err != nil {
+		t.Fatalf("creating queue: %s", err)
+	}
+
+	queueURL := aws.StringValue(createOutput.QueueUrl)
+
+	if u, err := url.Parse(queueURL); err != nil || u.Path != "/"+fakeaws.AccountID+"/test" {
+		t.Errorf("unexpected queue URL: %s", queueURL)
+	}
+
+	if _, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(map[string]string{sqs.QueueAttributeNameVisibilityTimeout: "60"}),
+		QueueUrl:   aws.String(queueURL),
+	}); err != nil {
+		t.Fatalf("setting queue attributes: %s", err)
+	}
+
+	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       aws.String(queueURL),
+	})
+
+	if err != nil {
+		t.Fatalf("getting queue attributes: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		sqs.QueueAttributeNameDelaySeconds:      "10",
+		sqs.QueueAttributeNameQueueArn:          "arn:aws:sqs:us-west-2:" + fakeaws.AccountID + ":test",
+		sqs.QueueAttributeNameVisibilityTimeout: "60",
+	} {
+		if got := aws.StringValue(attributesOutput.Attributes[k]); got != want {
+			t.Errorf("got attribute %s %q, want %q", k, got, want)
+		}
+	}
+
+	tagsOutput, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+
+	if err != nil {
+		t.Fatalf("listing queue tags: %s", err)
+	}
+
+	if got, want := aws.StringValue(tagsOutput.Tags["Name"]), "test"; got != want {
+		t.Errorf("got tag %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("deleting queue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       aws.String(queueURL),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("expected %s error, got: %v", sqs.ErrCodeQueueDoesNotExist, err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	conn := sns.New(newSession(t))
+
+	createOutput, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Attributes: aws.StringMap(map[string]string{"DisplayName": "Test"}),
+		Name:       aws.String("test"),
+		Tags:       []*sns.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	})
+
+	if err != nil {
+		t.Fatalf("creating topic: %s", err)
+	}
+
+	topicARN := aws.StringValue(createOutput.TopicArn)
+
+	if want := "arn:aws:sns:us-west-2:" + fakeaws.AccountID + ":test"; topicARN != want {
+		t.Errorf("got topic ARN %q, want %q", topicARN, want)
+	}
+
+	attributesOutput, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: aws.String(topicARN)})
+
+	if err != nil {
+		t.Fatalf("getting topic attributes: %s", err)
+	}
+
+	if got, want := aws.StringValue(attributesOutput.Attributes["DisplayName"]), "Test"; got != want {
+		t.Errorf("got display name %q, want %q", got, want)
+	}
+
+	if aws.StringValue(attributesOutput.Attributes["Policy"]) == "" {
+		t.Error("expected default topic policy")
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: aws.String(topicARN)})
+
+	if err != nil {
+		t.Fatalf("listing topic tags: %s", err)
+	}
+
+	if got, want := len(tagsOutput.Tags), 1; got != want {
+		t.Errorf("got %d tags, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}); err != nil {
+		t.Fatalf("deleting topic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: aws.String(topicARN)})
+
+	if !tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		t.Errorf("expected %s error, got: %v", sns.ErrCodeNotFoundException, err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	conn := ssm.New(newSession(t))
+
+	if _, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("/test/parameter"),
+		Tags:  []*ssm.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+		Type:  aws.String(ssm.ParameterTypeString),
+		Value: aws.String("one"),
+	}); err != nil {
+		t.Fatalf("putting parameter: %s", err)
+	}
+
+	_, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("/test/parameter"),
+		Type:  aws.String(ssm.ParameterTypeString),
+		Value: aws.String("two"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterAlreadyExists) {
+		t.Errorf("expected %s error, got: %v", ssm.ErrCodeParameterAlreadyExists, err)
+	}
+
+	if _, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String("/test/parameter"),
+		Overwrite: aws.Bool(true),
+		Value:     aws.String("two"),
+	}); err != nil {
+		t.Fatalf("overwriting parameter: %s", err)
+	}
+
+	getOutput, err := conn.GetParameter(&ssm.GetParameterInput{Name: aws.String("/test/parameter")})
+
+	if err != nil {
+		t.Fatalf("getting parameter: %s", err)
+	}
+
+	if got, want := aws.StringValue(getOutput.Parameter.Value), "two"; got != want {
+		t.Errorf("got value %q, want %q", got, want)
+	}
+
+	if got, want := aws.Int64Value(getOutput.Parameter.Version), int64(2); got != want {
+		t.Errorf("got version %d, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(getOutput.Parameter.ARN), "arn:aws:ssm:us-west-2:"+fakeaws.AccountID+":parameter/test/parameter"; got != want {
+		t.Errorf("got ARN %q, want %q", got, want)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String("/test/parameter"),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+	})
+
+	if err != nil {
+		t.Fatalf("listing parameter tags: %s", err)
+	}
+
+	if got, want := len(tagsOutput.TagList), 1; got != want {
+		t.Errorf("got %d tags, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(&ssm.DeleteParameterInput{Name: aws.String("/test/parameter")}); err != nil {
+		t.Fatalf("deleting parameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(&ssm.GetParameterInput{Name: aws.String("/test/parameter")})
+
+	if !tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+		t.Errorf("expected %s error, got: %v", ssm.ErrCodeParameterNotFound, err)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	conn := iam.New(newSession(t))
+
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	createOutput, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		Path:                     aws.String("/test/"),
+		RoleName:                 aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("creating role: %s", err)
+	}
+
+	if got, want := aws.StringValue(createOutput.Role.Arn), "arn:aws:iam::"+fakeaws.AccountID+":role/test/test"; got != want {
+		t.Errorf("got ARN %q, want %q", got, want)
+	}
+
+	getOutput, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("getting role: %s", err)
+	}
+
+	if got, err := url.QueryUnescape(aws.StringValue(getOutput.Role.AssumeRolePolicyDocument)); err != nil || got != policy {
+		t.Errorf("got assume role policy %q, want %q", got, policy)
+	}
+
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("attaching role policy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		t.Errorf("expected %s error, got: %v", iam.ErrCodeDeleteConflictException, err)
+	}
+
+	if _, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("detaching role policy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("deleting role: %s", err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("expected %s error, got: %v", iam.ErrCodeNoSuchEntityException, err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	conn := dynamodb.New(newSession(t))
+
+	createOutput, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{
+			AttributeName: aws.String("id"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		}},
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema: []*dynamodb.KeySchemaElement{{
+			AttributeName: aws.String("id"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		}},
+		TableName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("creating table: %s", err)
+	}
+
+	tableARN := aws.StringValue(createOutput.TableDescription.TableArn)
+
+	if want := "arn:aws:dynamodb:us-west-2:" + fakeaws.AccountID + ":table/test"; tableARN != want {
+		t.Errorf("got table ARN %q, want %q", tableARN, want)
+	}
+
+	if _, err := conn.TagResource(&dynamodb.TagResourceInput{
+		ResourceArn: aws.String(tableARN),
+		Tags:        []*dynamodb.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	}); err != nil {
+		t.Fatalf("tagging table: %s", err)
+	}
+
+	describeOutput, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("describing table: %s", err)
+	}
+
+	if got, want := aws.StringValue(describeOutput.Table.TableStatus), dynamodb.TableStatusActive; got != want {
+		t.Errorf("got table status %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(describeOutput.Table.BillingModeSummary.BillingMode), dynamodb.BillingModePayPerRequest; got != want {
+		t.Errorf("got billing mode %q, want %q", got, want)
+	}
+
+	tagsOutput, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{ResourceArn: aws.String(tableARN)})
+
+	if err != nil {
+		t.Fatalf("listing table tags: %s", err)
+	}
+
+	if got, want := len(tagsOutput.Tags), 1; got != want {
+		t.Errorf("got %d tags, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("deleting table: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("expected %s error, got: %v", dynamodb.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	conn := s3.New(newSession(t))
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket: aws.String("test"),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String("us-west-2"),
+		},
+	}); err != nil {
+		t.Fatalf("creating bucket: %s", err)
+	}
+
+	_, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, s3.ErrCodeBucketAlreadyOwnedByYou) {
+		t.Errorf("expected %s error, got: %v", s3.ErrCodeBucketAlreadyOwnedByYou, err)
+	}
+
+	locationOutput, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("getting bucket location: %s", err)
+	}
+
+	if got, want := aws.StringValue(locationOutput.LocationConstraint), "us-west-2"; got != want {
+		t.Errorf("got location constraint %q, want %q", got, want)
+	}
+
+	_, err = conn.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchTagSet") {
+		t.Errorf("expected NoSuchTagSet error, got: %v", err)
+	}
+
+	if _, err := conn.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket: aws.String("test"),
+		Tagging: &s3.Tagging{
+			TagSet: []*s3.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+		},
+	}); err != nil {
+		t.Fatalf("putting bucket tagging: %s", err)
+	}
+
+	taggingOutput, err := conn.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("getting bucket tagging: %s", err)
+	}
+
+	if got, want := len(taggingOutput.TagSet), 1; got != want {
+		t.Errorf("got %d tags, want %d", got, want)
+	}
+
+	versioningOutput, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("getting bucket versioning: %s", err)
+	}
+
+	if versioningOutput.Status != nil {
+		t.Errorf("got versioning status %q, want none", aws.StringValue(versioningOutput.Status))
+	}
+
+	if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("deleting bucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NotFound") {
+		t.Errorf("expected NotFound error, got: %v", err)
+	}
+}
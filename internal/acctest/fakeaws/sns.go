@@ -0,0 +1,250 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	snsErrCodeInvalidParameter = "InvalidParameter"
+	snsErrCodeNotFound         = "NotFound"
+)
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type snsService struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by topic ARN.
+}
+
+func newSNSService() *snsService {
+	return &snsService{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+func (s *snsService) actions() queryActions {
+	return queryActions{
+		"CreateTopic":         s.createTopic,
+		"DeleteTopic":         s.deleteTopic,
+		"GetTopicAttributes":  s.getTopicAttributes,
+		"ListTagsForResource": s.listTagsForResource,
+		"ListTopics":          s.listTopics,
+		"SetTopicAttributes":  s.setTopicAttributes,
+		"TagResource":         s.tagResource,
+		"UntagResource":       s.untagResource,
+	}
+}
+
+type snsEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type snsTopicARN struct {
+	TopicARN string `xml:"TopicArn"`
+}
+
+type snsGetTopicAttributesResult struct {
+	Attributes []snsEntry `xml:"Attributes>entry"`
+}
+
+type snsListTagsForResourceResult struct {
+	Tags []snsTag `xml:"Tags>member"`
+}
+
+type snsListTopicsResult struct {
+	Topics []snsTopicARN `xml:"Topics>member"`
+}
+
+func (s *snsService) topic(topicARN string) (*snsTopic, error) {
+	topic, ok := s.topics[topicARN]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, snsErrCodeNotFound, "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *snsService) createTopic(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := req.params.Get("Name")
+	attributes := req.keyValues("Attributes", "key", "value")
+
+	if strings.HasSuffix(name, ".fifo") != (attributes["FifoTopic"] == "true") {
+		return nil, newError(http.StatusBadRequest, snsErrCodeInvalidParameter, "Invalid parameter: Topic Name")
+	}
+
+	topicARN := arn(req.region, "sns", name)
+
+	if _, ok := s.topics[topicARN]; ok {
+		return &snsTopicARN{TopicARN: topicARN}, nil
+	}
+
+	topic := &snsTopic{
+		attributes: map[string]string{
+			"DisplayName":             "",
+			"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+			"Owner":                   AccountID,
+			"Policy":                  snsDefaultTopicPolicy(topicARN),
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsDeleted":    "0",
+			"SubscriptionsPending":    "0",
+			"TopicArn":                topicARN,
+		},
+		tags: req.keyValues("Tags", "Key", "Value"),
+	}
+
+	if attributes["FifoTopic"] == "true" {
+		topic.attributes["ContentBasedDeduplication"] = "false"
+	}
+
+	for k, v := range attributes {
+		topic.attributes[k] = v
+	}
+
+	s.topics[topicARN] = topic
+
+	return &snsTopicARN{TopicARN: topicARN}, nil
+}
+
+func snsDefaultTopicPolicy(topicARN string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%q,"Condition":{"StringEquals":{"AWS:SourceOwner":%q}}}]}`, topicARN, AccountID)
+}
+
+func (s *snsService) deleteTopic(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.topics, req.params.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (s *snsService) getTopicAttributes(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(req.params.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &snsGetTopicAttributesResult{}
+
+	for _, k := range sortedKeys(topic.attributes) {
+		result.Attributes = append(result.Attributes, snsEntry{Key: k, Value: topic.attributes[k]})
+	}
+
+	return result, nil
+}
+
+func (s *snsService) listTagsForResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(req.params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &snsListTagsForResourceResult{}
+
+	for _, k := range sortedKeys(topic.tags) {
+		result.Tags = append(result.Tags, snsTag{Key: k, Value: topic.tags[k]})
+	}
+
+	return result, nil
+}
+
+func (s *snsService) listTopics(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var topicARNs []string
+
+	for topicARN := range s.topics {
+		topicARNs = append(topicARNs, topicARN)
+	}
+
+	sort.Strings(topicARNs)
+
+	result := &snsListTopicsResult{}
+
+	for _, topicARN := range topicARNs {
+		result.Topics = append(result.Topics, snsTopicARN{TopicARN: topicARN})
+	}
+
+	return result, nil
+}
+
+func (s *snsService) setTopicAttributes(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(req.params.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	name, value := req.params.Get("AttributeName"), req.params.Get("AttributeValue")
+
+	if name == "Policy" && value == "" {
+		value = snsDefaultTopicPolicy(req.params.Get("TopicArn"))
+	}
+
+	topic.attributes[name] = value
+
+	return nil, nil
+}
+
+func (s *snsService) tagResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(req.params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range req.keyValues("Tags", "Key", "Value") {
+		topic.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) untagResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(req.params.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range req.list("TagKeys") {
+		delete(topic.tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,303 @@
+package fakeaws
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	sqsErrCodeInvalidParameterValue = "InvalidParameterValue"
+	sqsErrCodeQueueAlreadyExists    = "QueueAlreadyExists"
+	sqsErrCodeQueueDoesNotExist     = "AWS.SimpleQueueService.NonExistentQueue"
+)
+
+type sqsQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type sqsService struct {
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by queue name.
+}
+
+func newSQSService() *sqsService {
+	return &sqsService{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func (s *sqsService) actions() queryActions {
+	return queryActions{
+		"CreateQueue":        s.createQueue,
+		"DeleteQueue":        s.deleteQueue,
+		"GetQueueAttributes": s.getQueueAttributes,
+		"GetQueueUrl":        s.getQueueURL,
+		"ListQueueTags":      s.listQueueTags,
+		"ListQueues":         s.listQueues,
+		"SetQueueAttributes": s.setQueueAttributes,
+		"TagQueue":           s.tagQueue,
+		"UntagQueue":         s.untagQueue,
+	}
+}
+
+type sqsAttribute struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+type sqsTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type sqsCreateQueueResult struct {
+	QueueURL string `xml:"QueueUrl"`
+}
+
+type sqsGetQueueAttributesResult struct {
+	Attributes []sqsAttribute `xml:"Attribute"`
+}
+
+type sqsListQueuesResult struct {
+	QueueURLs []string `xml:"QueueUrl"`
+}
+
+type sqsListQueueTagsResult struct {
+	Tags []sqsTag `xml:"Tag"`
+}
+
+func sqsQueueURL(req *request, name string) string {
+	return (&url.URL{Scheme: "http", Host: req.Host, Path: path.Join("/", AccountID, name)}).String()
+}
+
+// queue returns the queue identified by the QueueUrl parameter.
+func (s *sqsService) queue(req *request) (string, *sqsQueue, error) {
+	u, err := url.Parse(req.params.Get("QueueUrl"))
+
+	if err != nil {
+		return "", nil, newError(http.StatusBadRequest, sqsErrCodeInvalidParameterValue, "%s", err)
+	}
+
+	name := path.Base(u.Path)
+	queue, ok := s.queues[name]
+
+	if !ok {
+		return "", nil, newError(http.StatusBadRequest, sqsErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+	}
+
+	return name, queue, nil
+}
+
+func (s *sqsService) createQueue(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := req.params.Get("QueueName")
+	attributes := req.keyValues("Attribute", "Name", "Value")
+
+	if strings.HasSuffix(name, ".fifo") != (attributes["FifoQueue"] == "true") {
+		return nil, newError(http.StatusBadRequest, sqsErrCodeInvalidParameterValue, "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix")
+	}
+
+	if queue, ok := s.queues[name]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return nil, newError(http.StatusBadRequest, sqsErrCodeQueueAlreadyExists, "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return &sqsCreateQueueResult{QueueURL: sqsQueueURL(req, name)}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	queue := &sqsQueue{
+		attributes: map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      now,
+			"DelaySeconds":                          "0",
+			"KmsDataKeyReusePeriodSeconds":          "300",
+			"LastModifiedTimestamp":                 now,
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              arn(req.region, "sqs", name),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"SqsManagedSseEnabled":                  "false",
+			"VisibilityTimeout":                     "30",
+		},
+		tags: req.keyValues("Tag", "Key", "Value"),
+	}
+
+	queue.setAttributes(attributes)
+	s.queues[name] = queue
+
+	return &sqsCreateQueueResult{QueueURL: sqsQueueURL(req, name)}, nil
+}
+
+// setAttributes sets the queue's attributes, removing any set to an empty value.
+func (q *sqsQueue) setAttributes(attributes map[string]string) {
+	for k, v := range attributes {
+		if v == "" {
+			delete(q.attributes, k)
+		} else {
+			q.attributes[k] = v
+		}
+	}
+}
+
+func (s *sqsService) deleteQueue(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, _, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, name)
+
+	return nil, nil
+}
+
+func (s *sqsService) getQueueAttributes(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	names := req.list("AttributeName")
+	all := len(names) == 0
+
+	for _, name := range names {
+		if name == "All" {
+			all = true
+		}
+	}
+
+	result := &sqsGetQueueAttributesResult{}
+
+	if all {
+		names = sortedKeys(queue.attributes)
+	}
+
+	for _, name := range names {
+		if v, ok := queue.attributes[name]; ok {
+			result.Attributes = append(result.Attributes, sqsAttribute{Name: name, Value: v})
+		}
+	}
+
+	return result, nil
+}
+
+func (s *sqsService) getQueueURL(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := req.params.Get("QueueName")
+
+	if _, ok := s.queues[name]; !ok {
+		return nil, newError(http.StatusBadRequest, sqsErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+	}
+
+	return &sqsCreateQueueResult{QueueURL: sqsQueueURL(req, name)}, nil
+}
+
+func (s *sqsService) listQueueTags(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqsListQueueTagsResult{}
+
+	for _, k := range sortedKeys(queue.tags) {
+		result.Tags = append(result.Tags, sqsTag{Key: k, Value: queue.tags[k]})
+	}
+
+	return result, nil
+}
+
+func (s *sqsService) listQueues(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := req.params.Get("QueueNamePrefix")
+	result := &sqsListQueuesResult{}
+
+	for name := range s.queues {
+		if strings.HasPrefix(name, prefix) {
+			result.QueueURLs = append(result.QueueURLs, sqsQueueURL(req, name))
+		}
+	}
+
+	sort.Strings(result.QueueURLs)
+
+	return result, nil
+}
+
+func (s *sqsService) setQueueAttributes(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	queue.setAttributes(req.keyValues("Attribute", "Name", "Value"))
+	queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+func (s *sqsService) tagQueue(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range req.keyValues("Tag", "Key", "Value") {
+		queue.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *sqsService) untagQueue(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range req.list("TagKey") {
+		delete(queue.tags, k)
+	}
+
+	return nil, nil
+}
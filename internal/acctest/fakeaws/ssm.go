@@ -0,0 +1,364 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ssmErrCodeInvalidResourceID        = "InvalidResourceId"
+	ssmErrCodeParameterAlreadyExists   = "ParameterAlreadyExists"
+	ssmErrCodeParameterNotFound        = "ParameterNotFound"
+	ssmErrCodeValidationException      = "ValidationException"
+	ssmResourceTypeForTaggingParameter = "Parameter"
+)
+
+type ssmTag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+type ssmParameter struct {
+	AllowedPattern   string  `json:"AllowedPattern,omitempty"`
+	ARN              string  `json:"ARN,omitempty"`
+	DataType         string  `json:"DataType"`
+	Description      string  `json:"Description,omitempty"`
+	KeyID            string  `json:"KeyId,omitempty"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	LastModifiedUser string  `json:"LastModifiedUser,omitempty"`
+	Name             string  `json:"Name"`
+	Tier             string  `json:"Tier,omitempty"`
+	Type             string  `json:"Type"`
+	Value            string  `json:"Value,omitempty"`
+	Version          int64   `json:"Version"`
+
+	tags map[string]string
+}
+
+type ssmService struct {
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by parameter name.
+}
+
+func newSSMService() *ssmService {
+	return &ssmService{
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+func (s *ssmService) actions() jsonActions {
+	return jsonActions{
+		"AddTagsToResource":      s.addTagsToResource,
+		"DeleteParameter":        s.deleteParameter,
+		"DescribeParameters":     s.describeParameters,
+		"GetParameter":           s.getParameter,
+		"ListTagsForResource":    s.listTagsForResource,
+		"PutParameter":           s.putParameter,
+		"RemoveTagsFromResource": s.removeTagsFromResource,
+	}
+}
+
+func (s *ssmService) parameter(name string) (*ssmParameter, error) {
+	parameter, ok := s.parameters[name]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, ssmErrCodeParameterNotFound, "Parameter %s not found.", name)
+	}
+
+	return parameter, nil
+}
+
+func (s *ssmService) putParameter(req *request) (interface{}, error) {
+	var input struct {
+		AllowedPattern *string
+		DataType       *string
+		Description    *string
+		KeyID          *string `json:"KeyId"`
+		Name           string
+		Overwrite      bool
+		Tags           []ssmTag
+		Tier           *string
+		Type           *string
+		Value          string
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, ok := s.parameters[input.Name]
+
+	if ok && !input.Overwrite {
+		return nil, newError(http.StatusBadRequest, ssmErrCodeParameterAlreadyExists, "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+	}
+
+	if input.Overwrite && len(input.Tags) > 0 {
+		return nil, newError(http.StatusBadRequest, ssmErrCodeValidationException, "Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+	}
+
+	if !ok {
+		if input.Type == nil {
+			return nil, newError(http.StatusBadRequest, ssmErrCodeValidationException, "A parameter type is required when you create a parameter.")
+		}
+
+		resource := "parameter/" + input.Name
+
+		if strings.HasPrefix(input.Name, "/") {
+			resource = "parameter" + input.Name
+		}
+
+		parameter = &ssmParameter{
+			ARN:      arn(req.region, "ssm", resource),
+			DataType: "text",
+			Name:     input.Name,
+			Tier:     "Standard",
+			tags:     make(map[string]string),
+		}
+
+		for _, tag := range input.Tags {
+			parameter.tags[tag.Key] = tag.Value
+		}
+
+		s.parameters[input.Name] = parameter
+	}
+
+	if input.AllowedPattern != nil {
+		parameter.AllowedPattern = *input.AllowedPattern
+	}
+
+	if input.DataType != nil && *input.DataType != "" {
+		parameter.DataType = *input.DataType
+	}
+
+	if input.Description != nil {
+		parameter.Description = *input.Description
+	}
+
+	if input.Tier != nil && *input.Tier != "" && *input.Tier != "Intelligent-Tiering" {
+		parameter.Tier = *input.Tier
+	}
+
+	if input.Type != nil {
+		parameter.Type = *input.Type
+	}
+
+	parameter.KeyID = ""
+
+	if parameter.Type == "SecureString" {
+		parameter.KeyID = "alias/aws/ssm"
+
+		if input.KeyID != nil {
+			parameter.KeyID = *input.KeyID
+		}
+	}
+
+	parameter.LastModifiedDate = epochTime(time.Now())
+	parameter.LastModifiedUser = arn("", "iam", "root")
+	parameter.Value = input.Value
+	parameter.Version++
+
+	return map[string]interface{}{
+		"Tier":    parameter.Tier,
+		"Version": parameter.Version,
+	}, nil
+}
+
+func (s *ssmService) deleteParameter(req *request) (interface{}, error) {
+	var input struct {
+		Name string
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.parameter(input.Name); err != nil {
+		return nil, err
+	}
+
+	delete(s.parameters, input.Name)
+
+	return nil, nil
+}
+
+func (s *ssmService) describeParameters(req *request) (interface{}, error) {
+	var input struct {
+		ParameterFilters []struct {
+			Key    string
+			Option string
+			Values []string
+		}
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var names []string
+
+	for name := range s.parameters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parameters := []ssmParameter{}
+
+	for _, name := range names {
+		match := true
+
+		for _, filter := range input.ParameterFilters {
+			if filter.Key != "Name" {
+				continue
+			}
+
+			matchValue := false
+
+			for _, v := range filter.Values {
+				if (filter.Option == "BeginsWith" && strings.HasPrefix(name, v)) || name == v {
+					matchValue = true
+				}
+			}
+
+			match = match && matchValue
+		}
+
+		if match {
+			// Parameter metadata doesn't include the value or ARN.
+			parameter := *s.parameters[name]
+			parameter.ARN = ""
+			parameter.Value = ""
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return map[string]interface{}{
+		"Parameters": parameters,
+	}, nil
+}
+
+func (s *ssmService) getParameter(req *request) (interface{}, error) {
+	var input struct {
+		Name string
+	}
+
+	if err := req.decode(&input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.parameter(input.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"Parameter": map[string]interface{}{
+			"ARN":              parameter.ARN,
+			"DataType":         parameter.DataType,
+			"LastModifiedDate": parameter.LastModifiedDate,
+			"Name":             parameter.Name,
+			"Type":             parameter.Type,
+			"Value":            parameter.Value,
+			"Version":          parameter.Version,
+		},
+	}, nil
+}
+
+type ssmTagResourceInput struct {
+	ResourceID   string `json:"ResourceId"`
+	ResourceType string
+	TagKeys      []string
+	Tags         []ssmTag
+}
+
+// taggedParameter returns the parameter identified by the tagging operation input.
+func (s *ssmService) taggedParameter(req *request) (*ssmTagResourceInput, *ssmParameter, error) {
+	var input ssmTagResourceInput
+
+	if err := req.decode(&input); err != nil {
+		return nil, nil, err
+	}
+
+	if input.ResourceType != ssmResourceTypeForTaggingParameter {
+		return nil, nil, newError(http.StatusBadRequest, ssmErrCodeValidationException, "Resource type %s is not implemented by the AWS stand-in", input.ResourceType)
+	}
+
+	parameter, ok := s.parameters[input.ResourceID]
+
+	if !ok {
+		return nil, nil, newError(http.StatusBadRequest, ssmErrCodeInvalidResourceID, "The resource ID %s is not valid.", input.ResourceID)
+	}
+
+	return &input, parameter, nil
+}
+
+func (s *ssmService) addTagsToResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	input, parameter, err := s.taggedParameter(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		parameter.tags[tag.Key] = tag.Value
+	}
+
+	return nil, nil
+}
+
+func (s *ssmService) listTagsForResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, parameter, err := s.taggedParameter(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []ssmTag{}
+
+	for _, k := range sortedKeys(parameter.tags) {
+		tags = append(tags, ssmTag{Key: k, Value: parameter.tags[k]})
+	}
+
+	return map[string]interface{}{
+		"TagList": tags,
+	}, nil
+}
+
+func (s *ssmService) removeTagsFromResource(req *request) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	input, parameter, err := s.taggedParameter(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range input.TagKeys {
+		delete(parameter.tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,27 @@
+package fakeaws
+
+type stsService struct{}
+
+func newSTSService() *stsService {
+	return &stsService{}
+}
+
+func (s *stsService) actions() queryActions {
+	return queryActions{
+		"GetCallerIdentity": s.getCallerIdentity,
+	}
+}
+
+type stsGetCallerIdentityResult struct {
+	Account string `xml:"Account"`
+	ARN     string `xml:"Arn"`
+	UserID  string `xml:"UserId"`
+}
+
+func (s *stsService) getCallerIdentity(req *request) (interface{}, error) {
+	return &stsGetCallerIdentityResult{
+		Account: AccountID,
+		ARN:     arn("", "iam", "user/fakeaws"),
+		UserID:  "AIDAIOSFODNN7EXAMPLE",
+	}, nil
+}
@@ -0,0 +1,54 @@
+package acctest
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// Semaphore limits the number of acceptance tests running concurrently, e.g. for resources with low quotas.
+// Client-side rate limiting of API requests is configured with the provider's rate_limits block instead.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a Semaphore with the specified default capacity,
+// which can be overridden by an integer environment variable.
+func NewSemaphore(envvar string, defaultLimit int) Semaphore {
+	limit := defaultLimit
+
+	if v := os.Getenv(envvar); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+
+		if err != nil {
+			panic(fmt.Errorf("could not parse %q: expected integer, got %q", envvar, v))
+		}
+	}
+
+	return make(Semaphore, limit)
+}
+
+// Wait blocks until the semaphore has capacity.
+func (s Semaphore) Wait() {
+	s <- struct{}{}
+}
+
+// Notify releases the semaphore.
+func (s Semaphore) Notify() {
+	// Don't block if Wait was never called.
+	select {
+	case <-s:
+	default:
+		log.Println("[WARN] Notifying semaphore without Wait")
+	}
+}
+
+// PreCheckSemaphore waits for the semaphore, skipping the test if the semaphore has no capacity.
+func PreCheckSemaphore(t *testing.T, semaphore Semaphore, resource string) {
+	if cap(semaphore) == 0 {
+		t.Skipf("concurrency for %s testing set to 0", resource)
+	}
+
+	semaphore.Wait()
+}
@@ -0,0 +1,36 @@
+package acctest
+
+import (
+	"testing"
+)
+
+func TestNewSemaphore(t *testing.T) {
+	t.Setenv("TF_TEST_SEMAPHORE_LIMIT", "")
+
+	if got, expected := cap(NewSemaphore("TF_TEST_SEMAPHORE_LIMIT", 5)), 5; got != expected {
+		t.Errorf("got capacity %d, expected %d", got, expected)
+	}
+
+	t.Setenv("TF_TEST_SEMAPHORE_LIMIT", "2")
+
+	s := NewSemaphore("TF_TEST_SEMAPHORE_LIMIT", 5)
+
+	if got, expected := cap(s), 2; got != expected {
+		t.Errorf("got capacity %d, expected %d", got, expected)
+	}
+
+	s.Wait()
+	s.Wait()
+
+	if got, expected := len(s), 2; got != expected {
+		t.Errorf("got %d holders, expected %d", got, expected)
+	}
+
+	s.Notify()
+	s.Notify()
+	s.Notify()
+
+	if got, expected := len(s), 0; got != expected {
+		t.Errorf("got %d holders, expected %d", got, expected)
+	}
+}
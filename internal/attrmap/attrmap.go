@@ -1,23 +1,49 @@
 package attrmap
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+// attributeType is the type of an AWS API attribute's value, when it isn't implied by the Terraform attribute's type.
+type attributeType int
+
+const (
+	attributeTypeDefault attributeType = iota
+	attributeTypeDuration
+	attributeTypeIAMPolicy
+	attributeTypeJSON
+	attributeTypeJSONObject
+)
+
 // AttributeMap represents a map of Terraform resource attribute name to AWS API attribute name.
 // Useful for SQS Queue or SNS Topic attribute handling.
 type attributeInfo struct {
 	apiAttributeName string
+	apiAttributeType attributeType
 	tfType           schema.ValueType
 	tfComputed       bool
 	tfOptional       bool
-	isIAMPolicy      bool
+	tfElem           interface{}
+
+	// apiDefaultValue is the value of the AWS API attribute when it isn't specified.
+	apiDefaultValue string
+
+	// durationUnit is the unit of a duration attribute's AWS API value.
+	durationUnit time.Duration
+
+	// jsonObjectKeys maps the Terraform attribute names of a JSON object attribute's nested block to JSON object keys.
+	jsonObjectKeys map[string]string
+
+	readOnly bool
 }
 
 type AttributeMap map[string]attributeInfo
@@ -31,6 +57,7 @@ func New(attrMap map[string]string, schemaMap map[string]*schema.Schema) Attribu
 			attributeInfo := attributeInfo{
 				apiAttributeName: apiAttributeName,
 				tfType:           s.Type,
+				tfElem:           s.Elem,
 			}
 
 			attributeInfo.tfComputed = s.Computed
@@ -48,44 +75,25 @@ func New(attrMap map[string]string, schemaMap map[string]*schema.Schema) Attribu
 // ApiAttributesToResourceData sets Terraform ResourceData from a map of AWS API attributes.
 func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]string, d *schema.ResourceData) error {
 	for tfAttributeName, attributeInfo := range m {
-		if v, ok := apiAttributes[attributeInfo.apiAttributeName]; ok {
-			var err error
-			var tfAttributeValue interface{}
-
-			switch t := attributeInfo.tfType; t {
-			case schema.TypeBool:
-				tfAttributeValue, err = strconv.ParseBool(v)
-
-				if err != nil {
-					return fmt.Errorf("error parsing %s value (%s) into boolean: %w", tfAttributeName, v, err)
-				}
-			case schema.TypeInt:
-				tfAttributeValue, err = strconv.Atoi(v)
+		v, ok := apiAttributes[attributeInfo.apiAttributeName]
 
-				if err != nil {
-					return fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
-				}
-			case schema.TypeString:
-				tfAttributeValue = v
+		if !ok && attributeInfo.apiDefaultValue != "" {
+			v, ok = attributeInfo.apiDefaultValue, true
+		}
 
-				if attributeInfo.isIAMPolicy {
-					policy, err := verify.PolicyToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
+		if !ok {
+			d.Set(tfAttributeName, nil)
+			continue
+		}
 
-					if err != nil {
-						return err
-					}
+		tfAttributeValue, err := attributeInfo.apiAttributeValueToTerraform(tfAttributeName, v, d.Get(tfAttributeName))
 
-					tfAttributeValue = policy
-				}
-			default:
-				return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
-			}
+		if err != nil {
+			return err
+		}
 
-			if err := d.Set(tfAttributeName, tfAttributeValue); err != nil {
-				return fmt.Errorf("error setting %s: %w", tfAttributeName, err)
-			}
-		} else {
-			d.Set(tfAttributeName, nil)
+		if err := d.Set(tfAttributeName, tfAttributeValue); err != nil {
+			return fmt.Errorf("error setting %s: %w", tfAttributeName, err)
 		}
 	}
 
@@ -99,40 +107,39 @@ func (m AttributeMap) ResourceDataToApiAttributesCreate(d *schema.ResourceData)
 
 	for tfAttributeName, attributeInfo := range m {
 		// Purely Computed values aren't specified on creation.
-		if attributeInfo.tfComputed && !attributeInfo.tfOptional {
+		if attributeInfo.readOnly || (attributeInfo.tfComputed && !attributeInfo.tfOptional) {
 			continue
 		}
 
 		var apiAttributeValue string
 		tfOptionalComputed := attributeInfo.tfComputed && attributeInfo.tfOptional
 
-		switch v, t := d.Get(tfAttributeName), attributeInfo.tfType; t {
-		case schema.TypeBool:
+		switch v, t := d.Get(tfAttributeName), attributeInfo.tfType; {
+		case attributeInfo.apiAttributeType != attributeTypeDefault:
+			var err error
+
+			apiAttributeValue, err = attributeInfo.terraformValueToAPIAttribute(tfAttributeName, v)
+
+			if err != nil {
+				return nil, err
+			}
+		case t == schema.TypeBool:
 			if v := v.(bool); v {
 				apiAttributeValue = strconv.FormatBool(v)
 			}
-		case schema.TypeInt:
+		case t == schema.TypeInt:
 			// On creation don't specify any zero Optional/Computed attribute integer values.
 			if v := v.(int); !tfOptionalComputed || v != 0 {
 				apiAttributeValue = strconv.Itoa(v)
 			}
-		case schema.TypeString:
+		case t == schema.TypeString:
 			apiAttributeValue = v.(string)
-
-			if attributeInfo.isIAMPolicy && apiAttributeValue != "" {
-				policy, err := structure.NormalizeJsonString(apiAttributeValue)
-
-				if err != nil {
-					return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-				}
-
-				apiAttributeValue = policy
-			}
 		default:
 			return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
 		}
 
-		if apiAttributeValue != "" {
+		// On creation don't specify any values that are the API's default.
+		if apiAttributeValue != "" && apiAttributeValue != attributeInfo.apiDefaultValue {
 			apiAttributes[attributeInfo.apiAttributeName] = apiAttributeValue
 		}
 	}
@@ -147,7 +154,7 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 
 	for tfAttributeName, attributeInfo := range m {
 		// Purely Computed values aren't specified on update.
-		if attributeInfo.tfComputed && !attributeInfo.tfOptional {
+		if attributeInfo.readOnly || (attributeInfo.tfComputed && !attributeInfo.tfOptional) {
 			continue
 		}
 
@@ -156,23 +163,21 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 
 			var apiAttributeValue string
 
-			switch t := attributeInfo.tfType; t {
-			case schema.TypeBool:
-				apiAttributeValue = strconv.FormatBool(v.(bool))
-			case schema.TypeInt:
-				apiAttributeValue = strconv.Itoa(v.(int))
-			case schema.TypeString:
-				apiAttributeValue = v.(string)
+			switch t := attributeInfo.tfType; {
+			case attributeInfo.apiAttributeType != attributeTypeDefault:
+				var err error
 
-				if attributeInfo.isIAMPolicy {
-					policy, err := structure.NormalizeJsonString(apiAttributeValue)
+				apiAttributeValue, err = attributeInfo.terraformValueToAPIAttribute(tfAttributeName, v)
 
-					if err != nil {
-						return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-					}
-
-					apiAttributeValue = policy
+				if err != nil {
+					return nil, err
 				}
+			case t == schema.TypeBool:
+				apiAttributeValue = strconv.FormatBool(v.(bool))
+			case t == schema.TypeInt:
+				apiAttributeValue = strconv.Itoa(v.(int))
+			case t == schema.TypeString:
+				apiAttributeValue = v.(string)
 			default:
 				return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
 			}
@@ -195,13 +200,340 @@ func (m AttributeMap) ApiAttributeNames() []string {
 	return apiAttributeNames
 }
 
+// ApiAttributesEqual returns whether the actual AWS API attributes match the expected ones, e.g. when waiting for attribute changes to propagate.
+// Typed attribute values are compared for equivalence, and missing attributes match empty or default expected values.
+func (m AttributeMap) ApiAttributesEqual(expected, actual map[string]string) bool {
+	attributeInfos := make(map[string]attributeInfo, len(m))
+
+	for _, attributeInfo := range m {
+		attributeInfos[attributeInfo.apiAttributeName] = attributeInfo
+	}
+
+	for k, e := range expected {
+		attributeInfo := attributeInfos[k]
+		a, ok := actual[k]
+
+		if !ok {
+			// Missing attribute equivalent to empty or default expected value.
+			if e == "" || (attributeInfo.apiDefaultValue != "" && e == attributeInfo.apiDefaultValue) {
+				continue
+			}
+
+			return false
+		}
+
+		switch attributeInfo.apiAttributeType {
+		case attributeTypeIAMPolicy:
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(a, e); err != nil || !equivalent {
+				return false
+			}
+		case attributeTypeJSON, attributeTypeJSONObject:
+			if !verify.JSONBytesEqual([]byte(a), []byte(e)) {
+				return false
+			}
+		default:
+			if a != e {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // WithIAMPolicyAttribute marks the specified Terraform attribute as holding an AWS IAM policy.
 // AWS IAM policies get special handling.
 // This method is intended to be chained with other similar helper methods in a builder pattern.
 func (m AttributeMap) WithIAMPolicyAttribute(tfAttributeName string) AttributeMap {
+	return m.withAttributeType(tfAttributeName, attributeTypeIAMPolicy)
+}
+
+// WithJSONAttribute marks the specified Terraform TypeString attribute as holding a JSON document, e.g. an SQS queue's RedrivePolicy.
+// JSON documents are normalized when sent to the API, and the existing value is kept on read if it is equivalent.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONAttribute(tfAttributeName string) AttributeMap {
+	return m.withAttributeType(tfAttributeName, attributeTypeJSON)
+}
+
+// WithJSONObjectAttribute marks the specified Terraform TypeList (MaxItems: 1) attribute as holding a JSON object.
+// The nested block's attributes map to the specified JSON object keys, e.g. "max_receive_count" to "maxReceiveCount".
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONObjectAttribute(tfAttributeName string, keys map[string]string) AttributeMap {
 	if attributeInfo, ok := m[tfAttributeName]; ok {
-		attributeInfo.isIAMPolicy = true
+		attributeInfo.apiAttributeType = attributeTypeJSONObject
+		attributeInfo.jsonObjectKeys = keys
+		m[tfAttributeName] = attributeInfo
 	}
 
 	return m
 }
+
+// WithDurationAttribute marks the specified Terraform TypeString attribute as holding a duration, e.g. "5m".
+// The AWS API attribute value is the duration as an integer number of the specified unit, e.g. time.Second.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithDurationAttribute(tfAttributeName string, unit time.Duration) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.apiAttributeType = attributeTypeDuration
+		attributeInfo.durationUnit = unit
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithDefaultValue sets the AWS API's default value of the specified Terraform attribute.
+// The attribute is not specified on create when it has the default value, and is set to the default value on read if the API omits it.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithDefaultValue(tfAttributeName string, v interface{}) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.apiDefaultValue = fmt.Sprint(v)
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithReadOnlyAttribute marks the specified Terraform attribute as read-only.
+// Read-only attributes are set on read but never sent to the API, e.g. attributes that can only be set by other API operations.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithReadOnlyAttribute(tfAttributeName string) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.readOnly = true
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+func (m AttributeMap) withAttributeType(tfAttributeName string, t attributeType) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.apiAttributeType = t
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// apiAttributeValueToTerraform converts an AWS API attribute value to a Terraform attribute value.
+// The existing Terraform value is used to preserve equivalent JSON documents and durations.
+func (attributeInfo attributeInfo) apiAttributeValueToTerraform(tfAttributeName, v string, existing interface{}) (interface{}, error) {
+	switch attributeInfo.apiAttributeType {
+	case attributeTypeDuration:
+		if v == "" {
+			return "", nil
+		}
+
+		n, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
+		}
+
+		duration := time.Duration(n) * attributeInfo.durationUnit
+
+		if existing := existing.(string); existing != "" {
+			if d, err := time.ParseDuration(existing); err == nil && d == duration {
+				return existing, nil
+			}
+		}
+
+		return duration.String(), nil
+
+	case attributeTypeIAMPolicy:
+		if v == "" {
+			return "", nil
+		}
+
+		return verify.PolicyToSet(existing.(string), v)
+
+	case attributeTypeJSON:
+		if v == "" {
+			return "", nil
+		}
+
+		if existing := existing.(string); verify.JSONBytesEqual([]byte(existing), []byte(v)) {
+			return existing, nil
+		}
+
+		json, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s value (%s) as JSON: %w", tfAttributeName, v, err)
+		}
+
+		return json, nil
+
+	case attributeTypeJSONObject:
+		return attributeInfo.jsonObjectToTerraform(tfAttributeName, v)
+	}
+
+	switch t := attributeInfo.tfType; t {
+	case schema.TypeBool:
+		tfAttributeValue, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s value (%s) into boolean: %w", tfAttributeName, v, err)
+		}
+
+		return tfAttributeValue, nil
+	case schema.TypeInt:
+		tfAttributeValue, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
+		}
+
+		return tfAttributeValue, nil
+	case schema.TypeString:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
+	}
+}
+
+// terraformValueToAPIAttribute converts a typed attribute's Terraform value to an AWS API attribute value.
+func (attributeInfo attributeInfo) terraformValueToAPIAttribute(tfAttributeName string, v interface{}) (string, error) {
+	switch attributeInfo.apiAttributeType {
+	case attributeTypeDuration:
+		v := v.(string)
+
+		if v == "" {
+			return "", nil
+		}
+
+		duration, err := time.ParseDuration(v)
+
+		if err != nil {
+			return "", fmt.Errorf("%s (%s) is an invalid duration: %w", tfAttributeName, v, err)
+		}
+
+		return strconv.FormatInt(int64(duration/attributeInfo.durationUnit), 10), nil
+
+	case attributeTypeIAMPolicy, attributeTypeJSON:
+		v := v.(string)
+
+		if v == "" {
+			return "", nil
+		}
+
+		json, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return "", fmt.Errorf("%s (%s) is invalid JSON: %w", tfAttributeName, v, err)
+		}
+
+		return json, nil
+
+	case attributeTypeJSONObject:
+		return attributeInfo.terraformToJSONObject(tfAttributeName, v)
+	}
+
+	return "", fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, attributeInfo.tfType)
+}
+
+func (attributeInfo attributeInfo) jsonObjectSchema(tfAttributeName string) (map[string]*schema.Schema, error) {
+	if attributeInfo.tfType != schema.TypeList {
+		return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, attributeInfo.tfType)
+	}
+
+	elem, ok := attributeInfo.tfElem.(*schema.Resource)
+
+	if !ok {
+		return nil, fmt.Errorf("attribute %s is not a block", tfAttributeName)
+	}
+
+	return elem.Schema, nil
+}
+
+// jsonObjectToTerraform converts a JSON object AWS API attribute value to a nested block.
+func (attributeInfo attributeInfo) jsonObjectToTerraform(tfAttributeName, v string) (interface{}, error) {
+	s, err := attributeInfo.jsonObjectSchema(tfAttributeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v == "" {
+		return []interface{}{}, nil
+	}
+
+	var apiObject map[string]interface{}
+
+	if err := json.Unmarshal([]byte(v), &apiObject); err != nil {
+		return nil, fmt.Errorf("error parsing %s value (%s) as JSON object: %w", tfAttributeName, v, err)
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, key := range attributeInfo.jsonObjectKeys {
+		raw, ok := apiObject[key]
+
+		if !ok || raw == nil {
+			continue
+		}
+
+		nested, ok := s[k]
+
+		if !ok {
+			return nil, fmt.Errorf("attribute %s has no nested attribute %s", tfAttributeName, k)
+		}
+
+		// Some APIs return numbers and booleans as JSON strings.
+		value := fmt.Sprint(raw)
+
+		switch t := nested.Type; t {
+		case schema.TypeBool:
+			tfMap[k], err = strconv.ParseBool(value)
+		case schema.TypeFloat:
+			tfMap[k], err = strconv.ParseFloat(value, 64)
+		case schema.TypeInt:
+			tfMap[k], err = strconv.Atoi(value)
+		case schema.TypeString:
+			tfMap[k] = value
+		default:
+			err = fmt.Errorf("unsupported type: %d", t)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s.%s value (%v): %w", tfAttributeName, k, raw, err)
+		}
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+// terraformToJSONObject converts a nested block to a JSON object AWS API attribute value.
+func (attributeInfo attributeInfo) terraformToJSONObject(tfAttributeName string, v interface{}) (string, error) {
+	if _, err := attributeInfo.jsonObjectSchema(tfAttributeName); err != nil {
+		return "", err
+	}
+
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return "", nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := map[string]interface{}{}
+
+	for k, key := range attributeInfo.jsonObjectKeys {
+		switch v := tfMap[k].(type) {
+		case nil:
+		case string:
+			if v != "" {
+				apiObject[key] = v
+			}
+		default:
+			apiObject[key] = v
+		}
+	}
+
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return "", fmt.Errorf("error marshaling %s to JSON: %w", tfAttributeName, err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,233 @@
+package attrmap_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
+)
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"delay": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"endpoint": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"filter": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"redrive": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dead_letter_target_arn": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"max_receive_count": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+		"reuse_period": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func testAttributeMap() attrmap.AttributeMap {
+	return attrmap.New(map[string]string{
+		"arn":          "Arn",
+		"delay":        "DelaySeconds",
+		"enabled":      "Enabled",
+		"endpoint":     "Endpoint",
+		"filter":       "FilterPolicy",
+		"policy":       "Policy",
+		"redrive":      "RedrivePolicy",
+		"reuse_period": "ReusePeriod",
+	}, testSchema()).
+		WithDurationAttribute("delay", time.Second).
+		WithJSONAttribute("filter").
+		WithIAMPolicyAttribute("policy").
+		WithJSONObjectAttribute("redrive", map[string]string{
+			"dead_letter_target_arn": "deadLetterTargetArn",
+			"max_receive_count":      "maxReceiveCount",
+		}).
+		WithDefaultValue("reuse_period", 300).
+		WithReadOnlyAttribute("endpoint")
+}
+
+func TestResourceDataToApiAttributesCreate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{
+		"delay":    "2m",
+		"enabled":  false,
+		"endpoint": "https://example.com",
+		"filter":   `{"b": [1], "a": "x"}`,
+		"policy":   `{"Version": "2012-10-17", "Statement": []}`,
+		"redrive": []interface{}{map[string]interface{}{
+			"dead_letter_target_arn": "arn:aws:sqs:us-west-2:123456789012:dlq", // lintignore:AWSAT003,AWSAT005
+			"max_receive_count":      5,
+		}},
+		"reuse_period": 300,
+	})
+
+	got, err := testAttributeMap().ResourceDataToApiAttributesCreate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"DelaySeconds":  "120",
+		"FilterPolicy":  `{"a":"x","b":[1]}`,
+		"Policy":        `{"Statement":[],"Version":"2012-10-17"}`,
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":5}`, // lintignore:AWSAT003,AWSAT005
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestResourceDataToApiAttributesUpdate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{
+		"enabled":      false,
+		"endpoint":     "https://example.com",
+		"reuse_period": 300,
+	})
+
+	got, err := testAttributeMap().ResourceDataToApiAttributesUpdate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"ReusePeriod": "300",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestApiAttributesToResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{
+		"delay":  "120s",
+		"filter": `{"b": [1], "a": "x"}`,
+	})
+
+	err := testAttributeMap().ApiAttributesToResourceData(map[string]string{
+		"Arn":           "arn:aws:sqs:us-west-2:123456789012:test", // lintignore:AWSAT003,AWSAT005
+		"DelaySeconds":  "120",
+		"Enabled":       "true",
+		"Endpoint":      "https://example.com",
+		"FilterPolicy":  `{"a":"x","b":[1]}`,
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":"5"}`, // lintignore:AWSAT003,AWSAT005
+	}, d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, expected := range map[string]interface{}{
+		"arn":                              "arn:aws:sqs:us-west-2:123456789012:test", // lintignore:AWSAT003,AWSAT005
+		"delay":                            "120s",
+		"enabled":                          true,
+		"endpoint":                         "https://example.com",
+		"filter":                           `{"b": [1], "a": "x"}`,
+		"policy":                           "",
+		"redrive.#":                        1,
+		"redrive.0.dead_letter_target_arn": "arn:aws:sqs:us-west-2:123456789012:dlq", // lintignore:AWSAT003,AWSAT005
+		"redrive.0.max_receive_count":      5,
+		"reuse_period":                     300,
+	} {
+		if got := d.Get(k); got != expected {
+			t.Errorf("got %s %#v, expected %#v", k, got, expected)
+		}
+	}
+}
+
+func TestApiAttributesEqual(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Expected map[string]string
+		Actual   map[string]string
+		Equal    bool
+	}{
+		{
+			TestName: "identical",
+			Expected: map[string]string{"Enabled": "true"},
+			Actual:   map[string]string{"Enabled": "true"},
+			Equal:    true,
+		},
+		{
+			TestName: "different",
+			Expected: map[string]string{"Enabled": "true"},
+			Actual:   map[string]string{"Enabled": "false"},
+		},
+		{
+			TestName: "missing empty",
+			Expected: map[string]string{"FilterPolicy": ""},
+			Actual:   map[string]string{},
+			Equal:    true,
+		},
+		{
+			TestName: "missing default",
+			Expected: map[string]string{"ReusePeriod": "300"},
+			Actual:   map[string]string{},
+			Equal:    true,
+		},
+		{
+			TestName: "missing",
+			Expected: map[string]string{"ReusePeriod": "60"},
+			Actual:   map[string]string{},
+		},
+		{
+			TestName: "equivalent JSON",
+			Expected: map[string]string{"FilterPolicy": `{"a":"x","b":[1]}`},
+			Actual:   map[string]string{"FilterPolicy": `{"b": [1], "a": "x"}`},
+			Equal:    true,
+		},
+		{
+			TestName: "equivalent IAM policy",
+			Expected: map[string]string{"Policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":"*"}]}`},
+			Actual:   map[string]string{"Policy": `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}}`},
+			Equal:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := testAttributeMap().ApiAttributesEqual(testCase.Expected, testCase.Actual); got != testCase.Equal {
+				t.Errorf("got %t, expected %t", got, testCase.Equal)
+			}
+		})
+	}
+}
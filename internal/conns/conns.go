@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -870,21 +872,27 @@ func init() {
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole // Roles to assume in order, each using the credentials of the previous one.
+	AssumeRoleWithWebIdentity      *AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURL                    string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	HTTPLogFile                    string
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]RateLimit
 	Region                         string
+	RetryMode                      string
 	S3UsePathStyle                 bool
 	SecretKey                      string
+	ServiceMaxRetries              map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -893,6 +901,7 @@ type Config struct {
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -1167,6 +1176,7 @@ type AWSClient struct {
 	SupportedPlatforms                []string
 	SWFConn                           *swf.SWF
 	SyntheticsConn                    *synthetics.Synthetics
+	TagPolicyConfig                   *tftags.PolicyConfig
 	TerraformVersion                  string
 	TextractConn                      *textract.Textract
 	TimestreamQueryConn               *timestreamquery.TimestreamQuery
@@ -1185,6 +1195,8 @@ type AWSClient struct {
 	WorkMailMessageFlowConn           *workmailmessageflow.WorkMailMessageFlow
 	WorkSpacesConn                    *workspaces.WorkSpaces
 	XRayConn                          *xray.XRay
+
+	regionalClients *regionalClientCache
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -1201,8 +1213,29 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
 
+// resolveEndpointURL applies the global endpoint URL to every service without a service-specific endpoint.
+func (c *Config) resolveEndpointURL() {
+	if c.EndpointURL == "" {
+		return
+	}
+
+	log.Printf("[INFO] Using endpoint URL for all services without a service-specific endpoint: %s", c.EndpointURL)
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	for _, serviceKey := range ServiceKeys() {
+		if c.Endpoints[serviceKey] == "" {
+			c.Endpoints[serviceKey] = c.EndpointURL
+		}
+	}
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
+	c.resolveEndpointURL()
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:               c.AccessKey,
 		APNInfo:                 StdUserAgentProducts(c.TerraformVersion),
@@ -1224,8 +1257,15 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		UseFIPSEndpoint:         c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The AWS SDK base doesn't support configuring web identity credentials, but requires credentials to load the AWS configuration.
+	// Load it with placeholder credentials and exchange the web identity token once it has been loaded,
+	// so that the exchange uses the configured HTTP client, retries and user agent.
+	webIdentity := c.AssumeRoleWithWebIdentity != nil && c.AssumeRoleWithWebIdentity.RoleARN != ""
+	if webIdentity {
+		awsbaseConfig.AccessKey = webIdentityPlaceholderAccessKey
+		awsbaseConfig.SecretKey = webIdentityPlaceholderSecretKey
+		awsbaseConfig.Token = ""
+		awsbaseConfig.Profile = ""
 	}
 
 	if c.CustomCABundle != "" {
@@ -1249,11 +1289,46 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	// The AWS SDK base validates credentials with its own HTTP client when loading the AWS configuration.
+	// If the HTTP transport is wrapped, credentials are instead validated when retrieving the account ID below.
+	// Web identity credentials are validated when the token is exchanged.
+	wrapTransport := httpTransportWrapper(ctx)
+	if wrapTransport != nil || webIdentity {
+		awsbaseConfig.SkipCredsValidation = true
+	}
+
 	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	// The AWS SDK base configures AWS SDK for Go v2 clients to log raw HTTP request and response bodies,
+	// which can contain secrets such as assumed role credentials.
+	cfg.ClientLogMode = 0
+
+	awsbaseConfig.SkipCredsValidation = c.SkipCredsValidation
+
+	if wrapTransport != nil {
+		cfg.HTTPClient = &http.Client{Transport: wrapTransport(httpClientTransport{client: cfg.HTTPClient})}
+	}
+
+	if webIdentity {
+		ar := c.AssumeRoleWithWebIdentity
+		log.Printf("[INFO] Assuming IAM Role %q with web identity (SessionName: %q)", ar.RoleARN, ar.SessionName)
+
+		cfg.Credentials = c.newWebIdentityCredentialsProvider(cfg)
+
+		// The credentials are cached, so these are the credentials used by the AWS clients until they expire.
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: assuming IAM Role (%s) with web identity: %s", ar.RoleARN, err)
+		}
+	}
+
+	// Roles are assumed here rather than by the AWS SDK base so that any number of roles can be chained.
+	if cfg.Credentials, err = c.assumeRoles(ctx, cfg); err != nil {
+		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -1266,6 +1341,31 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if wrapTransport != nil {
+		sess.Config.HTTPClient = wrapHTTPClient(sess.Config.HTTPClient, wrapTransport)
+	}
+
+	// The AWS SDK's debug logging includes raw HTTP request and response bodies, which can contain secrets.
+	// Log a redacted, structured record of each request instead.
+	sess.Config.LogLevel = aws.LogLevel(aws.LogOff)
+
+	requestLogger, err := newRequestLogger(c.HTTPLogFile)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	sess.Handlers.Complete.PushBackNamed(requestLogger.handler())
+
+	// Client-side rate limiting is applied before signing each request attempt, including retries.
+	if limiters := newRateLimiters(c.RateLimits); len(limiters) > 0 {
+		sess.Handlers.Sign.PushFrontNamed(rateLimitHandler(limiters))
+		cfg.APIOptions = append(cfg.APIOptions, rateLimitMiddleware(limiters))
+	}
+
+	if rc := newRetryConfig(c.RetryMode, c.ServiceMaxRetries); rc != nil {
+		rc.install(&sess.Handlers)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
@@ -1295,8 +1395,33 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		}
 	}
 
+	client := c.newClient(cfg, sess, c.Region, accountID, partition)
+	client.regionalClients = &regionalClientCache{
+		awsConfig: cfg,
+		clients:   make(map[string]*AWSClient),
+		config:    c,
+		session:   sess,
+	}
+	client.regionalClients.clients[c.Region] = client
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.SupportedPlatforms = supportedPlatforms
+		}
+	}
+
+	return client, nil
+}
+
+// newClient returns an AWSClient whose service clients are created from the specified configuration and session and operate in the specified region.
+func (c *Config) newClient(cfg awsv2.Config, sess *session.Session, region, accountID, partition string) *AWSClient {
 	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
 		DNSSuffix = p.DNSSuffix()
 	}
 
@@ -1516,7 +1641,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
 		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
 		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
-		Region:                            c.Region,
+		Region:                            region,
 		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
 		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
 		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
@@ -1567,6 +1692,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		SupportConn:                      support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
 		SWFConn:                          swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
 		SyntheticsConn:                   synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		TagPolicyConfig:                  c.TagPolicyConfig,
 		TerraformVersion:                 c.TerraformVersion,
 		TextractConn:                     textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
 		TimestreamQueryConn:              timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
@@ -1727,7 +1853,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			}
 		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
 			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
 					r.Retryable = aws.Bool(true)
 				}
 				return
@@ -1881,24 +2007,13 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
 		}
 	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.SupportedPlatforms = supportedPlatforms
-		}
-	}
-
-	return client, nil
+	return client
 }
 
 func StdUserAgentProducts(terraformVersion string) *awsbase.APNInfo {
@@ -2018,6 +2133,15 @@ func ServiceProviderNameUpper(key string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", key)
 }
 
+// ServiceEndpointsID returns the AWS SDK for Go v1 endpoints ID of the service, e.g. "iam".
+func ServiceEndpointsID(key string) string {
+	if v, ok := serviceData[key]; ok {
+		return v.AWSEndpointsID
+	}
+
+	return ""
+}
+
 func ServiceDeprecatedEnvVar(key string) string {
 	if v, ok := serviceData[key]; ok {
 		return v.DeprecatedEnvVar
@@ -1,12 +1,14 @@
 package conns
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	mockdatav1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/mockdata"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -131,3 +133,71 @@ var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesRes
     </item>
   </accountAttributeSet>
 </DescribeAccountAttributesResponse>`
+
+func TestConfigResolveEndpointURL(t *testing.T) {
+	const endpointURL = "http://localhost:4566"
+
+	c := &Config{
+		EndpointURL: endpointURL,
+		Endpoints: map[string]string{
+			S3: "http://localhost:9000",
+		},
+	}
+
+	c.resolveEndpointURL()
+
+	if got, expected := c.Endpoints[S3], "http://localhost:9000"; got != expected {
+		t.Errorf("got S3 endpoint %q, expected %q", got, expected)
+	}
+
+	for _, serviceKey := range []string{EC2, IAM, Route53Domains, STS} {
+		if got := c.Endpoints[serviceKey]; got != endpointURL {
+			t.Errorf("got %s endpoint %q, expected %q", serviceKey, got, endpointURL)
+		}
+	}
+
+	if c.SkipGetEC2Platforms {
+		t.Error("expected EC2 platforms lookup not to be skipped")
+	}
+
+	if c.SkipRequestingAccountId {
+		t.Error("expected account ID lookup not to be skipped")
+	}
+
+	c = &Config{}
+	c.resolveEndpointURL()
+
+	if len(c.Endpoints) != 0 || c.SkipGetEC2Platforms || c.SkipRequestingAccountId {
+		t.Errorf("unexpected configuration without endpoint URL: %#v", c)
+	}
+}
+
+func TestConfigClientEndpointURLRequestsAccountID(t *testing.T) {
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	t.Setenv(EnvVarProfile, "")
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+
+	c := &Config{
+		AccessKey:               fakeaws.AccessKeyID,
+		EndpointURL:             server.URL,
+		MaxRetries:              1,
+		Region:                  "us-west-2",
+		SecretKey:               fakeaws.SecretAccessKey,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: false,
+	}
+
+	raw, diags := c.Client(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := raw.(*AWSClient).AccountID, fakeaws.AccountID; got != expected {
+		t.Errorf("got account ID %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,210 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+const (
+	// webIdentityCredentialsSource is the credentials source reported for credentials obtained by AssumeRoleWithWebIdentity.
+	webIdentityCredentialsSource = "AssumeRoleWithWebIdentity"
+
+	// Placeholder static credentials used to load the AWS configuration before the web identity token is exchanged.
+	// They are never used to sign a request.
+	webIdentityPlaceholderAccessKey = "web-identity-placeholder"
+	webIdentityPlaceholderSecretKey = "web-identity-placeholder"
+)
+
+// AssumeRoleWithWebIdentity is the configuration for exchanging an OpenID Connect (OIDC) token for IAM Role credentials.
+type AssumeRoleWithWebIdentity struct {
+	Duration             time.Duration
+	Policy               string
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken returns the web identity token, reading it from file if necessary.
+// The token file is re-read on each call so that rotated tokens are picked up when credentials are refreshed.
+func (ar *AssumeRoleWithWebIdentity) webIdentityToken() (string, error) {
+	if ar.WebIdentityToken != "" {
+		return ar.WebIdentityToken, nil
+	}
+
+	if ar.WebIdentityTokenFile == "" {
+		return "", fmt.Errorf("one of web identity token or web identity token file must be set")
+	}
+
+	b, err := os.ReadFile(ar.WebIdentityTokenFile)
+
+	if err != nil {
+		return "", fmt.Errorf("reading web identity token file (%s): %w", ar.WebIdentityTokenFile, err)
+	}
+
+	return string(b), nil
+}
+
+// webIdentityCredentialsProvider implements aws.CredentialsProvider using STS AssumeRoleWithWebIdentity.
+type webIdentityCredentialsProvider struct {
+	client *sts.Client
+	config *AssumeRoleWithWebIdentity
+}
+
+func (p *webIdentityCredentialsProvider) Retrieve(ctx context.Context) (awsv2.Credentials, error) {
+	token, err := p.config.webIdentityToken()
+
+	if err != nil {
+		return awsv2.Credentials{}, err
+	}
+
+	sessionName := p.config.SessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          awsv2.String(p.config.RoleARN),
+		RoleSessionName:  awsv2.String(sessionName),
+		WebIdentityToken: awsv2.String(token),
+	}
+
+	if p.config.Duration > 0 {
+		input.DurationSeconds = awsv2.Int32(int32(p.config.Duration / time.Second))
+	}
+
+	if p.config.Policy != "" {
+		input.Policy = awsv2.String(p.config.Policy)
+	}
+
+	input.PolicyArns = expandPolicyDescriptors(p.config.PolicyARNs)
+
+	output, err := p.client.AssumeRoleWithWebIdentity(ctx, input)
+
+	if err != nil {
+		return awsv2.Credentials{}, err
+	}
+
+	return awsv2.Credentials{
+		AccessKeyID:     awsv2.ToString(output.Credentials.AccessKeyId),
+		SecretAccessKey: awsv2.ToString(output.Credentials.SecretAccessKey),
+		SessionToken:    awsv2.ToString(output.Credentials.SessionToken),
+		Source:          webIdentityCredentialsSource,
+		CanExpire:       true,
+		Expires:         awsv2.ToTime(output.Credentials.Expiration),
+	}, nil
+}
+
+// newWebIdentityCredentialsProvider returns a caching credentials provider that assumes the configured IAM Role with a web identity token,
+// using the HTTP client, retries and user agent from the specified AWS configuration.
+// AssumeRoleWithWebIdentity is an unsigned call, so the configuration's credentials aren't used.
+func (c *Config) newWebIdentityCredentialsProvider(cfg awsv2.Config) awsv2.CredentialsProvider {
+	client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if c.STSRegion != "" {
+			o.Region = c.STSRegion
+		}
+
+		if o.Region == "" {
+			// Use the global STS endpoint.
+			o.Region = "us-east-1"
+		}
+
+		if endpoint := c.Endpoints[STS]; endpoint != "" {
+			o.EndpointResolver = sts.EndpointResolverFromURL(endpoint)
+		}
+	})
+
+	return awsv2.NewCredentialsCache(&webIdentityCredentialsProvider{
+		client: client,
+		config: c.AssumeRoleWithWebIdentity,
+	})
+}
+
+// newAssumeRoleCredentialsProvider returns a caching credentials provider that assumes the specified IAM Role
+// using the credentials from the specified AWS configuration.
+func (c *Config) newAssumeRoleCredentialsProvider(cfg awsv2.Config, ar *awsbase.AssumeRole) awsv2.CredentialsProvider {
+	log.Printf("[INFO] Assuming IAM Role %q (SessionName: %q, ExternalId: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID)
+
+	client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if c.STSRegion != "" {
+			o.Region = c.STSRegion
+		}
+
+		if endpoint := c.Endpoints[STS]; endpoint != "" {
+			o.EndpointResolver = sts.EndpointResolverFromURL(endpoint)
+		}
+	})
+
+	return awsv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.Duration = ar.Duration
+		o.PolicyARNs = expandPolicyDescriptors(ar.PolicyARNs)
+		o.RoleSessionName = ar.SessionName
+		o.TransitiveTagKeys = ar.TransitiveTagKeys
+
+		if ar.ExternalID != "" {
+			o.ExternalID = awsv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			o.Policy = awsv2.String(ar.Policy)
+		}
+
+		for k, v := range ar.Tags {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   awsv2.String(k),
+				Value: awsv2.String(v),
+			})
+		}
+	}))
+}
+
+// assumeRoles assumes each configured IAM Role in turn, starting with the credentials from the specified AWS configuration.
+// The credentials provider for the last role is returned.
+// Each role's credentials are retrieved immediately so that any error identifies the role that could not be assumed.
+func (c *Config) assumeRoles(ctx context.Context, cfg awsv2.Config) (awsv2.CredentialsProvider, error) {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for _, ar := range c.AssumeRole {
+		if ar != nil && ar.RoleARN != "" {
+			assumeRoles = append(assumeRoles, ar)
+		}
+	}
+
+	for i, ar := range assumeRoles {
+		cfg.Credentials = c.newAssumeRoleCredentialsProvider(cfg, ar)
+
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			if len(assumeRoles) == 1 {
+				return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+			}
+
+			return nil, fmt.Errorf("assuming IAM Role (%s) (role %d of %d in assume_role chain): %w", ar.RoleARN, i+1, len(assumeRoles), err)
+		}
+	}
+
+	return cfg.Credentials, nil
+}
+
+func expandPolicyDescriptors(policyARNs []string) []ststypes.PolicyDescriptorType {
+	var apiObjects []ststypes.PolicyDescriptorType
+
+	for _, policyARN := range policyARNs {
+		apiObjects = append(apiObjects, ststypes.PolicyDescriptorType{
+			Arn: awsv2.String(policyARN),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,268 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+)
+
+func TestWebIdentityCredentialsProvider(t *testing.T) {
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	file, err := os.CreateTemp("", "aws-sdk-go-base-web-identity-token-file")
+	if err != nil {
+		t.Fatalf("unexpected error creating temporary web identity token file: %s", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(servicemocks.MockWebIdentityToken); err != nil {
+		t.Fatalf("unexpected error writing web identity token file: %s", err)
+	}
+	file.Close()
+
+	testCases := []struct {
+		Name   string
+		Config *AssumeRoleWithWebIdentity
+	}{
+		{
+			Name: "token",
+			Config: &AssumeRoleWithWebIdentity{
+				RoleARN:          servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+				SessionName:      servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+				WebIdentityToken: servicemocks.MockWebIdentityToken,
+			},
+		},
+		{
+			Name: "token file",
+			Config: &AssumeRoleWithWebIdentity{
+				RoleARN:              servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+				SessionName:          servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+				WebIdentityTokenFile: file.Name(),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AssumeRoleWithWebIdentity: testCase.Config,
+				Endpoints:                 map[string]string{STS: ts.URL},
+				Region:                    "us-east-1",
+			}
+
+			creds, err := config.newWebIdentityCredentialsProvider(awsv2.Config{}).Retrieve(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := creds.AccessKeyID, servicemocks.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %q, expected %q", got, expected)
+			}
+
+			if got, expected := creds.SessionToken, servicemocks.MockStsAssumeRoleWithWebIdentitySessionToken; got != expected {
+				t.Errorf("got session token %q, expected %q", got, expected)
+			}
+
+			if got, expected := creds.Source, webIdentityCredentialsSource; got != expected {
+				t.Errorf("got source %q, expected %q", got, expected)
+			}
+
+			if !creds.CanExpire {
+				t.Error("expected credentials to expire")
+			}
+		})
+	}
+}
+
+func TestConfigClientWithWebIdentity(t *testing.T) {
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		servicemocks.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	transport := &countingTransport{}
+	ctx := WithHTTPTransport(context.Background(), func(next http.RoundTripper) http.RoundTripper {
+		transport.next = next
+
+		return transport
+	})
+
+	c := &Config{
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:          servicemocks.MockStsAssumeRoleWithWebIdentityArn,
+			SessionName:      servicemocks.MockStsAssumeRoleWithWebIdentitySessionName,
+			WebIdentityToken: servicemocks.MockWebIdentityToken,
+		},
+		EndpointURL:         ts.URL,
+		Region:              "us-east-1",
+		SkipGetEC2Platforms: true,
+	}
+
+	meta, diags := c.Client(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The web identity token is exchanged once, through the configured HTTP client,
+	// and the resulting credentials are validated.
+	if got, expected := transport.requests, 2; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	creds, err := meta.(*AWSClient).regionalClients.awsConfig.Credentials.Retrieve(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := creds.AccessKeyID, servicemocks.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+		t.Errorf("got access key %q, expected %q", got, expected)
+	}
+
+	if got, expected := transport.requests, 2; got != expected {
+		t.Errorf("got %d requests after retrieving credentials, expected %d", got, expected)
+	}
+}
+
+func TestAssumeRoleWithWebIdentityWebIdentityToken(t *testing.T) {
+	ar := &AssumeRoleWithWebIdentity{
+		WebIdentityTokenFile: "/nonexistent/web-identity-token",
+	}
+
+	if _, err := ar.webIdentityToken(); err == nil {
+		t.Error("expected error for missing web identity token file")
+	}
+
+	if _, err := (&AssumeRoleWithWebIdentity{}).webIdentityToken(); err == nil {
+		t.Error("expected error for no web identity token")
+	}
+}
+
+func TestConfigAssumeRoles(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		AssumeRole    []*awsbase.AssumeRole
+		MockEndpoints []*servicemocks.MockEndpoint
+		ExpectedKey   string
+		ExpectedError string
+	}{
+		{
+			Name:        "none",
+			ExpectedKey: servicemocks.MockStaticAccessKey,
+		},
+		{
+			Name: "single",
+			AssumeRole: []*awsbase.AssumeRole{
+				{
+					Duration:    15 * time.Minute,
+					RoleARN:     servicemocks.MockStsAssumeRoleArn,
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			ExpectedKey: servicemocks.MockStsAssumeRoleAccessKey,
+		},
+		{
+			Name: "chain",
+			AssumeRole: []*awsbase.AssumeRole{
+				{
+					Duration:    15 * time.Minute,
+					RoleARN:     servicemocks.MockStsAssumeRoleArn,
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				},
+				{},
+				{
+					Duration:    15 * time.Minute,
+					ExternalID:  "ExternalID",
+					RoleARN:     servicemocks.MockStsAssumeRoleArn,
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{"ExternalId": "ExternalID"}),
+			},
+			ExpectedKey: servicemocks.MockStsAssumeRoleAccessKey,
+		},
+		{
+			Name: "chain failure",
+			AssumeRole: []*awsbase.AssumeRole{
+				{
+					Duration:    15 * time.Minute,
+					RoleARN:     servicemocks.MockStsAssumeRoleArn,
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				},
+				{
+					Duration:    15 * time.Minute,
+					RoleARN:     "arn:aws:iam::666666666666:role/Spoke",
+					SessionName: servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			MockEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			ExpectedError: "assuming IAM Role (arn:aws:iam::666666666666:role/Spoke) (role 2 of 2 in assume_role chain)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ts := servicemocks.MockAwsApiServer("STS", testCase.MockEndpoints)
+			defer ts.Close()
+
+			config := &Config{
+				AssumeRole: testCase.AssumeRole,
+				Endpoints:  map[string]string{STS: ts.URL},
+			}
+			cfg := awsv2.Config{
+				Credentials: credentials.NewStaticCredentialsProvider(servicemocks.MockStaticAccessKey, servicemocks.MockStaticSecretKey, ""),
+				Region:      "us-east-1",
+			}
+
+			provider, err := config.assumeRoles(context.Background(), cfg)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.ExpectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error %q, got %q", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			creds, err := provider.Retrieve(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if got, expected := creds.AccessKeyID, testCase.ExpectedKey; got != expected {
+				t.Errorf("got access key %q, expected %q", got, expected)
+			}
+		})
+	}
+}
@@ -54,6 +54,12 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording the AWS API interactions of acceptance tests into cassette files
+	EnvVarAccRecord = "TF_ACC_RECORD"
+
+	// For replaying the AWS API interactions of acceptance tests from cassette files, without AWS credentials
+	EnvVarAccReplay = "TF_ACC_REPLAY"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,445 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const redactedValue = "<sensitive>"
+
+// sensitiveFields lists, by AWS SDK service name and operation, the names of request and response fields whose values are never logged.
+// Fields are matched by name at any depth.
+// This supplements the AWS SDK's `sensitive` struct tags, which not all sensitive fields have.
+var sensitiveFields = map[string]map[string][]string{
+	acm.ServiceName: {
+		"ExportCertificate": {"Passphrase", "PrivateKey"},
+		"ImportCertificate": {"PrivateKey"},
+	},
+	directoryservice.ServiceName: {
+		"ConnectDirectory":  {"CustomerPassword"},
+		"CreateComputer":    {"Password"},
+		"CreateDirectory":   {"Password"},
+		"CreateMicrosoftAD": {"Password"},
+		"CreateTrust":       {"TrustPassword"},
+		"EnableRadius":      {"SharedSecret"},
+		"ResetUserPassword": {"NewPassword"},
+		"UpdateRadius":      {"SharedSecret"},
+	},
+	ecr.ServiceName: {
+		"GetAuthorizationToken": {"AuthorizationToken"},
+	},
+	elasticache.ServiceName: {
+		"CreateCacheCluster":     {"AuthToken"},
+		"CreateReplicationGroup": {"AuthToken"},
+		"CreateUser":             {"Passwords"},
+		"ModifyCacheCluster":     {"AuthToken"},
+		"ModifyReplicationGroup": {"AuthToken"},
+		"ModifyUser":             {"Passwords"},
+	},
+	iam.ServiceName: {
+		"ChangePassword":                  {"NewPassword", "OldPassword"},
+		"CreateAccessKey":                 {"SecretAccessKey"},
+		"CreateLoginProfile":              {"Password"},
+		"CreateServiceSpecificCredential": {"ServicePassword"},
+		"ResetServiceSpecificCredential":  {"ServicePassword"},
+		"UpdateLoginProfile":              {"Password"},
+		"UploadServerCertificate":         {"PrivateKey"},
+	},
+	kms.ServiceName: {
+		"Decrypt":             {"Plaintext"},
+		"Encrypt":             {"Plaintext"},
+		"GenerateDataKey":     {"Plaintext"},
+		"GenerateDataKeyPair": {"PrivateKeyPlaintext"},
+		"GenerateRandom":      {"Plaintext"},
+	},
+	mq.ServiceName: {
+		"CreateBroker": {"Password"},
+		"CreateUser":   {"Password"},
+		"UpdateUser":   {"Password"},
+	},
+	rds.ServiceName: {
+		"CreateDBCluster":                 {"MasterUserPassword"},
+		"CreateDBInstance":                {"MasterUserPassword", "TdeCredentialPassword"},
+		"ModifyDBCluster":                 {"MasterUserPassword"},
+		"ModifyDBInstance":                {"MasterUserPassword", "TdeCredentialPassword"},
+		"RestoreDBClusterFromS3":          {"MasterUserPassword"},
+		"RestoreDBInstanceFromS3":         {"MasterUserPassword"},
+		"RestoreDBInstanceFromDBSnapshot": {"TdeCredentialPassword"},
+	},
+	redshift.ServiceName: {
+		"CreateCluster":         {"MasterUserPassword"},
+		"GetClusterCredentials": {"DbPassword"},
+		"ModifyCluster":         {"MasterUserPassword"},
+	},
+	secretsmanager.ServiceName: {
+		"CreateSecret":      {"SecretBinary", "SecretString"},
+		"GetRandomPassword": {"RandomPassword"},
+		"GetSecretValue":    {"SecretBinary", "SecretString"},
+		"PutSecretValue":    {"SecretBinary", "SecretString"},
+		"UpdateSecret":      {"SecretBinary", "SecretString"},
+	},
+	ssm.ServiceName: {
+		"GetParameter":        {"Value"},
+		"GetParameterHistory": {"Value"},
+		"GetParameters":       {"Value"},
+		"GetParametersByPath": {"Value"},
+		"PutParameter":        {"Value"},
+	},
+	sts.ServiceName: {
+		"AssumeRole":                {"SecretAccessKey", "SessionToken"},
+		"AssumeRoleWithSAML":        {"SAMLAssertion", "SecretAccessKey", "SessionToken"},
+		"AssumeRoleWithWebIdentity": {"SecretAccessKey", "SessionToken", "WebIdentityToken"},
+		"GetFederationToken":        {"SecretAccessKey", "SessionToken"},
+		"GetSessionToken":           {"SecretAccessKey", "SessionToken"},
+	},
+}
+
+// SensitiveFieldNames returns the names of the sensitive request and response fields of the AWS API operations
+// named operationName in any service, or of all operations if operationName is empty.
+func SensitiveFieldNames(operationName string) []string {
+	var names []string
+
+	for _, operations := range sensitiveFields {
+		for operation, fields := range operations {
+			if operationName == "" || operation == operationName {
+				names = append(names, fields...)
+			}
+		}
+	}
+
+	return names
+}
+
+// requestLogRecord is the structured log record for an AWS API request.
+type requestLogRecord struct {
+	Time         time.Time   `json:"time"`
+	Service      string      `json:"service"`
+	Operation    string      `json:"operation"`
+	Region       string      `json:"region,omitempty"`
+	RequestID    string      `json:"request_id,omitempty"`
+	StatusCode   int         `json:"status_code,omitempty"`
+	Attempts     int         `json:"attempts"`
+	RetryCount   int         `json:"retry_count"`
+	Duration     float64     `json:"duration_ms"`     // Total time, including retries and waits.
+	LastAttempt  float64     `json:"last_attempt_ms"` // Time taken by the final attempt.
+	ErrorCode    string      `json:"error_code,omitempty"`
+	ErrorMessage string      `json:"error_message,omitempty"`
+	Params       interface{} `json:"params,omitempty"`
+	Response     interface{} `json:"response,omitempty"`
+}
+
+// requestLogger logs a redacted, structured record of each AWS API request.
+// It replaces the AWS SDK's debug logging, which logs raw HTTP request and response bodies.
+type requestLogger struct {
+	file io.Writer // Optional JSON-lines output. Records written here omit request and response bodies.
+}
+
+// requestLogFile is a request log file shared by all request loggers writing to it.
+type requestLogFile struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (f *requestLogFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Write(p)
+}
+
+// requestLogFiles holds the open request log files, keyed by path.
+// Each file is opened once, for the life of the provider process, rather than each time the provider is configured.
+var requestLogFiles struct {
+	sync.Mutex
+	files map[string]*requestLogFile
+}
+
+// openRequestLogFile returns the open request log file for the specified path, opening it if necessary.
+func openRequestLogFile(path string) (*requestLogFile, error) {
+	requestLogFiles.Lock()
+	defer requestLogFiles.Unlock()
+
+	if f, ok := requestLogFiles.files[path]; ok {
+		return f, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening HTTP log file (%s): %w", path, err)
+	}
+
+	log.Printf("[DEBUG] Writing AWS API request records to: %s", path)
+
+	if requestLogFiles.files == nil {
+		requestLogFiles.files = make(map[string]*requestLogFile)
+	}
+
+	file := &requestLogFile{file: f}
+	requestLogFiles.files[path] = file
+
+	return file, nil
+}
+
+// newRequestLogger returns a request logger, optionally also writing records to the specified JSON-lines file.
+func newRequestLogger(path string) (*requestLogger, error) {
+	logger := &requestLogger{}
+
+	if path != "" {
+		f, err := openRequestLogFile(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		logger.file = f
+	}
+
+	return logger, nil
+}
+
+// handler returns a request handler that logs a record when the request completes.
+func (l *requestLogger) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tfconns.RequestLogHandler",
+		Fn:   l.logRequest,
+	}
+}
+
+func (l *requestLogger) logRequest(r *request.Request) {
+	debug := logging.IsDebugOrHigher()
+
+	if !debug && l.file == nil {
+		return
+	}
+
+	// Request and response bodies are only included in the debug log.
+	record := newRequestLogRecord(r, debug)
+
+	if l.file != nil {
+		metadata := record
+		metadata.Params = nil
+		metadata.Response = nil
+
+		if b, err := json.Marshal(metadata); err == nil {
+			_, err = l.file.Write(append(b, '\n'))
+
+			if err != nil {
+				log.Printf("[WARN] Writing AWS API request record: %s", err)
+			}
+		}
+	}
+
+	if !debug {
+		return
+	}
+
+	if b, err := json.Marshal(record); err == nil {
+		log.Printf("[DEBUG] [aws-sdk-go] %s", b)
+	}
+}
+
+// newRequestLogRecord returns the structured log record for the completed request,
+// optionally including the redacted request parameters and response.
+func newRequestLogRecord(r *request.Request, withBodies bool) requestLogRecord {
+	now := time.Now()
+	serviceName, operationName := r.ClientInfo.ServiceName, ""
+
+	if r.Operation != nil {
+		operationName = r.Operation.Name
+	}
+
+	record := requestLogRecord{
+		Time:       r.Time,
+		Service:    serviceName,
+		Operation:  operationName,
+		RequestID:  r.RequestID,
+		Attempts:   r.RetryCount + 1,
+		RetryCount: r.RetryCount,
+		Duration:   milliseconds(now.Sub(r.Time)),
+	}
+
+	if r.Config.Region != nil {
+		record.Region = *r.Config.Region
+	}
+
+	if !r.AttemptTime.IsZero() {
+		record.LastAttempt = milliseconds(now.Sub(r.AttemptTime))
+	}
+
+	if r.HTTPResponse != nil {
+		record.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			record.ErrorCode = awsErr.Code()
+			record.ErrorMessage = awsErr.Message()
+		} else {
+			record.ErrorMessage = r.Error.Error()
+		}
+	}
+
+	if withBodies {
+		redactor := newRedactor(serviceName, operationName)
+		record.Params = redactor.redact(reflect.ValueOf(r.Params))
+
+		if r.Error == nil {
+			record.Response = redactor.redact(reflect.ValueOf(r.Data))
+		}
+	}
+
+	return record
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// redactor converts AWS SDK request and response structures to loggable values with sensitive fields redacted.
+type redactor struct {
+	fields map[string]struct{}
+}
+
+func newRedactor(serviceName, operationName string) *redactor {
+	r := &redactor{
+		fields: make(map[string]struct{}),
+	}
+
+	for _, field := range sensitiveFields[serviceName][operationName] {
+		r.fields[field] = struct{}{}
+	}
+
+	return r
+}
+
+// redact returns a JSON-serializable representation of the value.
+// Struct fields tagged `sensitive:"true"` or listed as sensitive for the operation are replaced with a placeholder,
+// streaming bodies are omitted and binary data is summarized by length.
+func (r *redactor) redact(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(readerType) && v.Kind() != reflect.Ptr {
+		return "<stream>"
+	}
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+
+		return r.redact(v.Elem())
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+
+		m := make(map[string]interface{})
+		t := v.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			ft := t.Field(i)
+
+			if ft.PkgPath != "" {
+				continue // Unexported.
+			}
+
+			fv := v.Field(i)
+
+			if isNil(fv) {
+				continue
+			}
+
+			if _, ok := r.fields[ft.Name]; ok || ft.Tag.Get("sensitive") == "true" {
+				m[ft.Name] = redactedValue
+				continue
+			}
+
+			if ft.Type.Implements(readerType) {
+				m[ft.Name] = "<stream>"
+				continue
+			}
+
+			m[ft.Name] = r.redact(fv)
+		}
+
+		return m
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<%d bytes>", v.Len())
+		}
+
+		s := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			s[i] = r.redact(v.Index(i))
+		}
+
+		return s
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = r.redact(iter.Value())
+		}
+
+		return m
+
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return nil
+
+	default:
+		return v.Interface()
+	}
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
+	}
+
+	return false
+}
@@ -0,0 +1,271 @@
+package conns
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestRedactorRedact(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Service   string
+		Operation string
+		Value     interface{}
+		Expected  interface{}
+	}{
+		{
+			Name:      "nil",
+			Service:   ssm.ServiceName,
+			Operation: "GetParameters",
+			Value:     (*ssm.GetParametersOutput)(nil),
+			Expected:  nil,
+		},
+		{
+			Name:      "operation fields",
+			Service:   ssm.ServiceName,
+			Operation: "GetParameters",
+			Value: &ssm.GetParametersOutput{
+				Parameters: []*ssm.Parameter{
+					{
+						Name:    aws.String("/test"),
+						Type:    aws.String(ssm.ParameterTypeSecureString),
+						Value:   aws.String("secret"),
+						Version: aws.Int64(1),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"Parameters": []interface{}{
+					map[string]interface{}{
+						"Name":    "/test",
+						"Type":    ssm.ParameterTypeSecureString,
+						"Value":   redactedValue,
+						"Version": int64(1),
+					},
+				},
+			},
+		},
+		{
+			Name:      "other operation",
+			Service:   ssm.ServiceName,
+			Operation: "DescribeParameters",
+			Value: &ssm.Tag{
+				Key:   aws.String("Value"),
+				Value: aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"Key":   "Value",
+				"Value": "test",
+			},
+		},
+		{
+			Name:      "sensitive tag",
+			Service:   secretsmanager.ServiceName,
+			Operation: "DescribeSecret",
+			Value: &secretsmanager.GetSecretValueOutput{
+				Name:         aws.String("test"),
+				SecretString: aws.String("secret"),
+			},
+			Expected: map[string]interface{}{
+				"Name":         "test",
+				"SecretString": redactedValue,
+			},
+		},
+		{
+			Name:      "binary",
+			Service:   kms.ServiceName,
+			Operation: "Encrypt",
+			Value: &kms.EncryptOutput{
+				CiphertextBlob: []byte("ciphertext"),
+				KeyId:          aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"CiphertextBlob": "<10 bytes>",
+				"KeyId":          "test",
+			},
+		},
+		{
+			Name:      "stream",
+			Service:   s3.ServiceName,
+			Operation: "PutObject",
+			Value: &s3.PutObjectInput{
+				Body:     strings.NewReader("body"),
+				Bucket:   aws.String("test"),
+				Metadata: map[string]*string{"key": aws.String("value")},
+			},
+			Expected: map[string]interface{}{
+				"Body":     "<stream>",
+				"Bucket":   "test",
+				"Metadata": map[string]interface{}{"key": "value"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := newRedactor(testCase.Service, testCase.Operation).redact(reflect.ValueOf(testCase.Value))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSensitiveFieldNames(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Operation string
+		Contains  []string
+		Excludes  []string
+	}{
+		{
+			Name:      "operation",
+			Operation: "CreateDBInstance",
+			Contains:  []string{"MasterUserPassword", "TdeCredentialPassword"},
+			Excludes:  []string{"SecretString"},
+		},
+		{
+			Name:      "operation in several services",
+			Operation: "CreateUser",
+			Contains:  []string{"Password", "Passwords"},
+		},
+		{
+			Name:      "unknown operation",
+			Operation: "ListQueues",
+			Excludes:  []string{"Password", "SecretString"},
+		},
+		{
+			Name:     "all operations",
+			Contains: []string{"MasterUserPassword", "SecretString", "WebIdentityToken"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := make(map[string]bool)
+
+		for _, name := range SensitiveFieldNames(testCase.Operation) {
+			got[name] = true
+		}
+
+		for _, name := range testCase.Contains {
+			if !got[name] {
+				t.Errorf("%s: expected %q in sensitive field names", testCase.Name, name)
+			}
+		}
+
+		for _, name := range testCase.Excludes {
+			if got[name] {
+				t.Errorf("%s: unexpected %q in sensitive field names", testCase.Name, name)
+			}
+		}
+	}
+}
+
+func TestRequestLoggerFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.jsonl")
+
+	logger, err := newRequestLogger(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := request.New(
+		aws.Config{Region: aws.String("us-west-2")},
+		metadata.ClientInfo{ServiceName: secretsmanager.ServiceName},
+		request.Handlers{},
+		nil,
+		&request.Operation{Name: "GetSecretValue"},
+		&secretsmanager.GetSecretValueInput{SecretId: aws.String("test")},
+		&secretsmanager.GetSecretValueOutput{SecretString: aws.String("secret")},
+	)
+	r.RequestID = "01234567-89ab-cdef-0123-456789abcdef"
+	r.RetryCount = 2
+
+	logger.logRequest(r)
+	logger.logRequest(r)
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer f.Close()
+
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		if strings.Contains(scanner.Text(), "secret\"") {
+			t.Errorf("record contains secret: %s", scanner.Text())
+		}
+
+		var record map[string]interface{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("unexpected error decoding record: %s", err)
+		}
+
+		records = append(records, record)
+	}
+
+	if got, expected := len(records), 2; got != expected {
+		t.Fatalf("got %d records, expected %d", got, expected)
+	}
+
+	record := records[0]
+
+	for k, expected := range map[string]interface{}{
+		"service":     secretsmanager.ServiceName,
+		"operation":   "GetSecretValue",
+		"region":      "us-west-2",
+		"request_id":  "01234567-89ab-cdef-0123-456789abcdef",
+		"attempts":    float64(3),
+		"retry_count": float64(2),
+	} {
+		if got := record[k]; got != expected {
+			t.Errorf("got %s %#v, expected %#v", k, got, expected)
+		}
+	}
+
+	for _, k := range []string{"params", "response"} {
+		if _, ok := record[k]; ok {
+			t.Errorf("unexpected %s in record", k)
+		}
+	}
+}
+
+func TestRequestLoggerFileShared(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.jsonl")
+
+	logger1, err := newRequestLogger(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	logger2, err := newRequestLogger(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if logger1.file != logger2.file {
+		t.Error("expected request loggers to share the open file")
+	}
+}
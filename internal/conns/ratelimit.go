@@ -0,0 +1,196 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	awsmiddlewarev2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// RateLimit is the client-side request rate limit configured for a service.
+type RateLimit struct {
+	RequestsPerSecond float64 // Sustained request rate.
+	Burst             int     // Maximum number of requests that can be made at once. Defaults to the ceiling of RequestsPerSecond.
+}
+
+// RateLimiter is a token bucket rate limiter.
+// It can be shared by any number of concurrent callers.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // Tokens added per second.
+	burst  float64 // Bucket capacity.
+	tokens float64 // Available tokens; negative when there are outstanding reservations.
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter for the specified limit.
+// The bucket starts full.
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	burst := float64(limit.Burst)
+
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+	}
+
+	return &RateLimiter{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done.
+// It returns the time spent waiting.
+// If the context is done before a token becomes available, the token is returned to the bucket and the context's error is returned.
+func (l *RateLimiter) Wait(ctx context.Context) (time.Duration, error) {
+	delay := l.reserve()
+
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return 0, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// setRate changes the rate at which tokens are added to the bucket.
+// The bucket capacity is adjusted to match the new rate.
+func (l *RateLimiter) setRate(rate float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.rate = rate
+	l.burst = math.Max(1, math.Ceil(rate))
+	l.tokens = math.Min(l.burst, l.tokens)
+}
+
+// rateLimitHandler returns a request handler that waits on the rate limiter for the request's service before each attempt.
+// Rate limiters are keyed by AWS SDK service name (ServiceDatum.AWSServiceName).
+func rateLimitHandler(limiters map[string]*RateLimiter) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tfconns.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			limiter, ok := limiters[r.ClientInfo.ServiceName]
+
+			if !ok {
+				return
+			}
+
+			delay, err := limiter.Wait(r.Context())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			if delay > 0 {
+				log.Printf("[DEBUG] Rate limited %s/%s (attempt %d): waited %s", r.ClientInfo.ServiceName, r.Operation.Name, r.RetryCount+1, delay)
+			}
+		},
+	}
+}
+
+// rateLimitMiddleware returns an AWS SDK for Go v2 API option that waits on the rate limiter for the operation's service before each attempt.
+// It is the counterpart of rateLimitHandler for clients built from an aws.Config and shares its rate limiters.
+func rateLimitMiddleware(limiters map[string]*RateLimiter) func(*middleware.Stack) error {
+	// AWS SDK for Go v2 clients identify their service by service ID rather than service name.
+	serviceIDLimiters := make(map[string]*RateLimiter)
+
+	for _, sd := range serviceData {
+		if limiter, ok := limiters[sd.AWSServiceName]; ok && sd.AWSServiceID != "" {
+			serviceIDLimiters[sd.AWSServiceID] = limiter
+		}
+	}
+
+	return func(stack *middleware.Stack) error {
+		m := middleware.FinalizeMiddlewareFunc("tfconns.RateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			serviceID := awsmiddlewarev2.GetServiceID(ctx)
+
+			if limiter, ok := serviceIDLimiters[serviceID]; ok {
+				delay, err := limiter.Wait(ctx)
+
+				if err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+
+				if delay > 0 {
+					log.Printf("[DEBUG] Rate limited %s/%s: waited %s", serviceID, awsmiddlewarev2.GetOperationName(ctx), delay)
+				}
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		// As for AWS SDK for Go v1 clients, wait after the retry middleware so that each attempt is limited,
+		// and before signing so that the signature doesn't expire while waiting.
+		if _, ok := stack.Finalize.Get("Signing"); ok {
+			return stack.Finalize.Insert(m, "Signing", middleware.Before)
+		}
+
+		return stack.Finalize.Add(m, middleware.After)
+	}
+}
+
+// newRateLimiters returns rate limiters, keyed by AWS SDK service name, for the specified per-service rate limits.
+// Rate limits are keyed by service key (e.g. conns.EC2).
+func newRateLimiters(rateLimits map[string]RateLimit) map[string]*RateLimiter {
+	limiters := make(map[string]*RateLimiter)
+
+	for serviceKey, limit := range rateLimits {
+		sd, ok := serviceData[serviceKey]
+
+		if !ok || limit.RequestsPerSecond <= 0 {
+			continue
+		}
+
+		limiter := NewRateLimiter(limit)
+
+		log.Printf("[DEBUG] Rate limiting %s to %g requests per second (burst %g)", serviceKey, limiter.rate, limiter.burst)
+
+		limiters[sd.AWSServiceName] = limiter
+	}
+
+	return limiters
+}
@@ -0,0 +1,118 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{RequestsPerSecond: 10, Burst: 2})
+
+	for i := 0; i < 2; i++ {
+		delay, err := limiter.Wait(context.Background())
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if delay != 0 {
+			t.Errorf("request %d: expected no delay within burst, got %s", i, delay)
+		}
+	}
+
+	delay, err := limiter.Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if delay <= 0 || delay > 100*time.Millisecond {
+		t.Errorf("expected delay in (0, 100ms], got %s", delay)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{RequestsPerSecond: 0.01})
+
+	if _, err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// The canceled reservation must have been returned to the bucket.
+	if limiter.tokens < -0.5 {
+		t.Errorf("expected canceled reservation to be returned, tokens: %g", limiter.tokens)
+	}
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	limiters := newRateLimiters(map[string]RateLimit{
+		EC2:     {RequestsPerSecond: 20},
+		Route53: {RequestsPerSecond: 5, Burst: 10},
+		IAM:     {},
+		"nope":  {RequestsPerSecond: 1},
+	})
+
+	if got, expected := len(limiters), 2; got != expected {
+		t.Fatalf("got %d rate limiters, expected %d", got, expected)
+	}
+
+	if limiter, ok := limiters[serviceData[EC2].AWSServiceName]; !ok {
+		t.Error("expected EC2 rate limiter")
+	} else if limiter.burst != 20 {
+		t.Errorf("got EC2 burst %g, expected 20", limiter.burst)
+	}
+
+	if limiter, ok := limiters[serviceData[Route53].AWSServiceName]; !ok {
+		t.Error("expected Route 53 rate limiter")
+	} else if limiter.burst != 10 {
+		t.Errorf("got Route 53 burst %g, expected 10", limiter.burst)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	limiters := newRateLimiters(map[string]RateLimit{
+		Route53Domains: {RequestsPerSecond: 0.01},
+	})
+
+	cfg := awsv2.Config{
+		Region:      "us-east-1", //lintignore:AWSAT003
+		Credentials: credentials.NewStaticCredentialsProvider("AKIAEXAMPLE", "secret", ""),
+		APIOptions:  []func(*middleware.Stack) error{rateLimitMiddleware(limiters)},
+	}
+	conn := route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
+		o.EndpointResolver = route53domains.EndpointResolverFromURL(server.URL)
+	})
+
+	if _, err := conn.ListDomains(context.Background(), &route53domains.ListDomainsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// The bucket is empty, so the next request waits longer than the context's deadline.
+	if _, err := conn.ListDomains(ctx, &route53domains.ListDomainsInput{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// regionalClientCache lazily creates and caches AWSClients for regions other than the provider's.
+// The provider's AWSClient and all of its regional AWSClients share the same cache.
+type regionalClientCache struct {
+	awsConfig awsv2.Config
+	config    *Config
+	session   *session.Session
+
+	mu      sync.Mutex
+	clients map[string]*AWSClient // Keyed by region.
+}
+
+// RegionalClient returns an AWSClient whose service clients operate in the specified region.
+// The AWSClient is created from the provider's session on first use and then cached.
+// If region is empty or is the receiver's region, the receiver is returned.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	cache := client.regionalClients
+
+	if cache == nil {
+		return nil, fmt.Errorf("creating AWS client for region (%s): provider not configured for regional clients", region)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if regionalClient, ok := cache.clients[region]; ok {
+		return regionalClient, nil
+	}
+
+	if !cache.config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != client.Partition {
+		return nil, fmt.Errorf("creating AWS client for region (%s): region is in partition (%s), provider is configured for partition (%s)", region, p.ID(), client.Partition)
+	}
+
+	log.Printf("[DEBUG] Creating AWS client for region: %s", region)
+
+	awsConfig := cache.awsConfig.Copy()
+	awsConfig.Region = region
+
+	regionalClient := cache.config.newClient(awsConfig, cache.session.Copy(&aws.Config{Region: aws.String(region)}), region, client.AccountID, client.Partition)
+	regionalClient.SupportedPlatforms = client.SupportedPlatforms
+	regionalClient.regionalClients = cache
+
+	cache.clients[region] = regionalClient
+
+	return regionalClient, nil
+}
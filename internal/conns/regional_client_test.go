@@ -0,0 +1,85 @@
+package conns
+
+import (
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	config := &Config{Region: endpoints.UsWest2RegionID}
+	client := config.newClient(awsv2.Config{Region: endpoints.UsWest2RegionID}, sess, endpoints.UsWest2RegionID, "123456789012", endpoints.AwsPartitionID)
+	client.regionalClients = &regionalClientCache{
+		awsConfig: awsv2.Config{Region: endpoints.UsWest2RegionID},
+		clients:   map[string]*AWSClient{endpoints.UsWest2RegionID: client},
+		config:    config,
+		session:   sess,
+	}
+
+	for _, region := range []string{"", endpoints.UsWest2RegionID} {
+		got, err := client.RegionalClient(region)
+
+		if err != nil {
+			t.Fatalf("unexpected error for region (%s): %s", region, err)
+		}
+
+		if got != client {
+			t.Errorf("expected provider client for region (%s)", region)
+		}
+	}
+
+	got, err := client.RegionalClient(endpoints.EuWest1RegionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Region != endpoints.EuWest1RegionID {
+		t.Errorf("got region %s, expected %s", got.Region, endpoints.EuWest1RegionID)
+	}
+
+	if got.AccountID != client.AccountID {
+		t.Errorf("got account ID %s, expected %s", got.AccountID, client.AccountID)
+	}
+
+	if v := aws.StringValue(got.EC2Conn.Config.Region); v != endpoints.EuWest1RegionID {
+		t.Errorf("got EC2 client region %s, expected %s", v, endpoints.EuWest1RegionID)
+	}
+
+	if again, _ := got.RegionalClient(endpoints.EuWest1RegionID); again != got {
+		t.Error("expected cached regional client")
+	}
+
+	if again, _ := client.RegionalClient(endpoints.EuWest1RegionID); again != got {
+		t.Error("expected regional client cache to be shared")
+	}
+
+	if back, _ := got.RegionalClient(endpoints.UsWest2RegionID); back != client {
+		t.Error("expected provider client from regional client")
+	}
+
+	if _, err := client.RegionalClient("not-a-region-1"); err == nil {
+		t.Error("expected error for invalid region")
+	}
+
+	if _, err := client.RegionalClient(endpoints.CnNorth1RegionID); err == nil {
+		t.Error("expected error for region in another partition")
+	}
+
+	if _, err := (&AWSClient{Region: endpoints.UsWest2RegionID}).RegionalClient(endpoints.EuWest1RegionID); err == nil {
+		t.Error("expected error for unconfigured client")
+	}
+}
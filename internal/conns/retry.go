@@ -0,0 +1,269 @@
+package conns
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Retry modes.
+const (
+	// RetryModeAdaptive is RetryModeStandard plus client-side rate limiting that slows all callers of a service's clients while throttling is observed.
+	RetryModeAdaptive = "adaptive"
+	// RetryModeLegacy is the AWS SDK for Go v1 default retry behavior.
+	RetryModeLegacy = "legacy"
+	// RetryModeStandard uses exponential backoff with full jitter, capped at standardMaxBackoff.
+	RetryModeStandard = "standard"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeLegacy,
+		RetryModeStandard,
+	}
+}
+
+const (
+	standardMaxBackoff = 20 * time.Second
+
+	adaptiveMinRate      = 0.5  // Requests per second.
+	adaptiveMaxRate      = 1000 // Requests per second. Above this the adaptive rate limiter is disabled again.
+	adaptiveDecreaseRate = 0.7  // Multiplier applied when throttling is observed.
+	adaptiveIncreaseRate = 1.05 // Multiplier applied on each successful request.
+)
+
+// retryConfig replaces each request's retryer according to the retry mode and per-service maximum number of retries.
+type retryConfig struct {
+	mode              string
+	serviceMaxRetries map[string]int // Keyed by AWS SDK service name (ServiceDatum.AWSServiceName).
+
+	mu       sync.Mutex
+	limiters map[string]*adaptiveRateLimiter // Keyed by AWS SDK service name.
+}
+
+// newRetryConfig returns a retryConfig for the specified retry mode and per-service maximum number of retries.
+// Per-service maximum number of retries are keyed by service key (e.g. conns.Route53).
+// A nil value is returned if the AWS SDK's default retry handling need not be changed.
+func newRetryConfig(mode string, serviceMaxRetries map[string]int) *retryConfig {
+	if mode == "" {
+		mode = RetryModeLegacy
+	}
+
+	rc := &retryConfig{
+		mode:              mode,
+		serviceMaxRetries: make(map[string]int),
+		limiters:          make(map[string]*adaptiveRateLimiter),
+	}
+
+	for serviceKey, maxRetries := range serviceMaxRetries {
+		sd, ok := serviceData[serviceKey]
+
+		if !ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Setting %s maximum number of retries to %d", serviceKey, maxRetries)
+
+		rc.serviceMaxRetries[sd.AWSServiceName] = maxRetries
+	}
+
+	if rc.mode == RetryModeLegacy && len(rc.serviceMaxRetries) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Using retry mode: %s", rc.mode)
+
+	return rc
+}
+
+// install adds the retry handlers to the specified request handlers.
+func (rc *retryConfig) install(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tfconns.RetryerHandler",
+		Fn:   rc.setRetryer,
+	})
+
+	if rc.mode != RetryModeAdaptive {
+		return
+	}
+
+	// Wait before signing each attempt, as with rate limits.
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "tfconns.AdaptiveRateLimitHandler",
+		Fn: func(r *request.Request) {
+			delay, err := rc.limiter(r.ClientInfo.ServiceName).wait(r)
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			if delay > 0 {
+				log.Printf("[DEBUG] Adaptive rate limited %s/%s (attempt %d): waited %s", r.ClientInfo.ServiceName, r.Operation.Name, r.RetryCount+1, delay)
+			}
+		},
+	})
+	handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "tfconns.AdaptiveThrottleHandler",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				rc.limiter(r.ClientInfo.ServiceName).throttled(r.ClientInfo.ServiceName)
+			}
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tfconns.AdaptiveSuccessHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				rc.limiter(r.ClientInfo.ServiceName).succeeded(r.ClientInfo.ServiceName)
+			}
+		},
+	})
+}
+
+// setRetryer replaces the request's retryer.
+func (rc *retryConfig) setRetryer(r *request.Request) {
+	maxRetries := r.MaxRetries()
+
+	if v, ok := rc.serviceMaxRetries[r.ClientInfo.ServiceName]; ok {
+		maxRetries = v
+	}
+
+	switch rc.mode {
+	case RetryModeLegacy:
+		// Preserve any service-specific retry delays.
+		retryer, ok := r.Retryer.(client.DefaultRetryer)
+
+		if !ok {
+			retryer = client.DefaultRetryer{}
+		}
+
+		retryer.NumMaxRetries = maxRetries
+		r.Retryer = retryer
+
+	default:
+		r.Retryer = standardRetryer{numMaxRetries: maxRetries}
+	}
+}
+
+// limiter returns the adaptive rate limiter for the specified AWS SDK service name.
+func (rc *retryConfig) limiter(serviceName string) *adaptiveRateLimiter {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	limiter, ok := rc.limiters[serviceName]
+
+	if !ok {
+		limiter = &adaptiveRateLimiter{}
+		rc.limiters[serviceName] = limiter
+	}
+
+	return limiter
+}
+
+// standardRetryer implements request.Retryer using exponential backoff with full jitter.
+type standardRetryer struct {
+	numMaxRetries int
+}
+
+func (s standardRetryer) MaxRetries() int {
+	return s.numMaxRetries
+}
+
+func (s standardRetryer) ShouldRetry(r *request.Request) bool {
+	if r.Retryable != nil {
+		return *r.Retryable
+	}
+
+	return r.IsErrorRetryable() || r.IsErrorThrottle()
+}
+
+func (s standardRetryer) RetryRules(r *request.Request) time.Duration {
+	backoff := standardMaxBackoff
+
+	// Avoid overflow for large retry counts.
+	if r.RetryCount < 5 {
+		backoff = time.Duration(math.Min(float64(standardMaxBackoff), float64(time.Second<<r.RetryCount)))
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// adaptiveRateLimiter is a rate limiter that is enabled when throttling is first observed.
+// While enabled its rate decreases multiplicatively on throttling and increases slowly on success.
+type adaptiveRateLimiter struct {
+	mu      sync.Mutex
+	limiter *RateLimiter // nil while disabled.
+	rate    float64      // Current rate, in requests per second, while enabled.
+
+	// Requests sent in the current one-second window, used to seed the rate when throttling is first observed.
+	windowStart  time.Time
+	windowCount  int
+	measuredRate float64
+}
+
+// wait records a request attempt and, if enabled, waits on the rate limiter.
+func (l *adaptiveRateLimiter) wait(r *request.Request) (time.Duration, error) {
+	l.mu.Lock()
+
+	now := time.Now()
+
+	if now.Sub(l.windowStart) >= time.Second {
+		l.measuredRate = float64(l.windowCount) / math.Max(1, now.Sub(l.windowStart).Seconds())
+		l.windowStart = now
+		l.windowCount = 0
+	}
+
+	l.windowCount++
+	limiter := l.limiter
+
+	l.mu.Unlock()
+
+	if limiter == nil {
+		return 0, nil
+	}
+
+	return limiter.Wait(r.Context())
+}
+
+// throttled decreases the rate, enabling the rate limiter if necessary.
+func (l *adaptiveRateLimiter) throttled(serviceName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limiter == nil {
+		l.rate = math.Max(adaptiveMinRate, math.Max(l.measuredRate, float64(l.windowCount)))
+		l.limiter = NewRateLimiter(RateLimit{RequestsPerSecond: l.rate})
+	}
+
+	l.rate = math.Max(adaptiveMinRate, l.rate*adaptiveDecreaseRate)
+	l.limiter.setRate(l.rate)
+
+	log.Printf("[DEBUG] Throttling observed for %s, adaptive rate limit decreased to %g requests per second", serviceName, l.rate)
+}
+
+// succeeded increases the rate, disabling the rate limiter once the rate is high enough.
+func (l *adaptiveRateLimiter) succeeded(serviceName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limiter == nil {
+		return
+	}
+
+	l.rate *= adaptiveIncreaseRate
+
+	if l.rate > adaptiveMaxRate {
+		log.Printf("[DEBUG] Adaptive rate limit disabled for %s", serviceName)
+		l.limiter = nil
+		return
+	}
+
+	l.limiter.setRate(l.rate)
+}
@@ -0,0 +1,181 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestNewRetryConfig(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Mode              string
+		ServiceMaxRetries map[string]int
+		ExpectNil         bool
+	}{
+		{
+			Name:      "default",
+			ExpectNil: true,
+		},
+		{
+			Name:      "legacy",
+			Mode:      RetryModeLegacy,
+			ExpectNil: true,
+		},
+		{
+			Name: "legacy with overrides",
+			Mode: RetryModeLegacy,
+			ServiceMaxRetries: map[string]int{
+				Route53: 50,
+			},
+		},
+		{
+			Name: "standard",
+			Mode: RetryModeStandard,
+		},
+		{
+			Name: "adaptive",
+			Mode: RetryModeAdaptive,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := newRetryConfig(testCase.Mode, testCase.ServiceMaxRetries)
+
+			if testCase.ExpectNil && got != nil {
+				t.Errorf("expected nil, got %#v", got)
+			} else if !testCase.ExpectNil && got == nil {
+				t.Error("expected non-nil")
+			}
+		})
+	}
+}
+
+func TestRetryConfigSetRetryer(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Mode               string
+		ServiceName        string
+		ExpectedMaxRetries int
+		ExpectStandard     bool
+	}{
+		{
+			Name:               "legacy override",
+			Mode:               RetryModeLegacy,
+			ServiceName:        serviceData[Route53].AWSServiceName,
+			ExpectedMaxRetries: 50,
+		},
+		{
+			Name:               "legacy no override",
+			Mode:               RetryModeLegacy,
+			ServiceName:        serviceData[S3].AWSServiceName,
+			ExpectedMaxRetries: 25,
+		},
+		{
+			Name:               "standard override",
+			Mode:               RetryModeStandard,
+			ServiceName:        serviceData[Route53].AWSServiceName,
+			ExpectedMaxRetries: 50,
+			ExpectStandard:     true,
+		},
+		{
+			Name:               "standard no override",
+			Mode:               RetryModeStandard,
+			ServiceName:        serviceData[S3].AWSServiceName,
+			ExpectedMaxRetries: 25,
+			ExpectStandard:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			rc := newRetryConfig(testCase.Mode, map[string]int{Route53: 50})
+
+			r := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: testCase.ServiceName},
+				Retryer:    client.DefaultRetryer{NumMaxRetries: 25, MinRetryDelay: 1 * time.Second},
+			}
+
+			rc.setRetryer(r)
+
+			if got := r.MaxRetries(); got != testCase.ExpectedMaxRetries {
+				t.Errorf("got %d max retries, expected %d", got, testCase.ExpectedMaxRetries)
+			}
+
+			switch retryer := r.Retryer.(type) {
+			case standardRetryer:
+				if !testCase.ExpectStandard {
+					t.Errorf("unexpected retryer type: %T", retryer)
+				}
+			case client.DefaultRetryer:
+				if testCase.ExpectStandard {
+					t.Errorf("unexpected retryer type: %T", retryer)
+				}
+
+				if retryer.MinRetryDelay != 1*time.Second {
+					t.Errorf("expected service-specific retry delay to be preserved, got %s", retryer.MinRetryDelay)
+				}
+			default:
+				t.Errorf("unexpected retryer type: %T", retryer)
+			}
+		})
+	}
+}
+
+func TestStandardRetryerRetryRules(t *testing.T) {
+	retryer := standardRetryer{numMaxRetries: 100}
+
+	for retryCount := 0; retryCount < 100; retryCount++ {
+		upper := standardMaxBackoff
+		if retryCount < 5 && time.Second<<retryCount < upper {
+			upper = time.Second << retryCount
+		}
+
+		got := retryer.RetryRules(&request.Request{RetryCount: retryCount})
+
+		if got < 0 || got >= upper {
+			t.Errorf("retry %d: got delay %s, expected in range [0, %s)", retryCount, got, upper)
+		}
+	}
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	l := &adaptiveRateLimiter{}
+
+	l.succeeded("test")
+
+	if l.limiter != nil {
+		t.Fatal("expected rate limiter to be disabled before throttling is observed")
+	}
+
+	l.windowCount = 10
+	l.throttled("test")
+
+	if l.limiter == nil {
+		t.Fatal("expected rate limiter to be enabled after throttling is observed")
+	}
+
+	if expected := 10 * adaptiveDecreaseRate; l.rate != expected {
+		t.Errorf("got rate %g, expected %g", l.rate, expected)
+	}
+
+	for i := 0; i < 100 && l.rate > adaptiveMinRate; i++ {
+		l.throttled("test")
+	}
+
+	if l.rate != adaptiveMinRate {
+		t.Errorf("got rate %g, expected minimum %g", l.rate, adaptiveMinRate)
+	}
+
+	for i := 0; i < 1000 && l.limiter != nil; i++ {
+		l.succeeded("test")
+	}
+
+	if l.limiter != nil {
+		t.Errorf("expected rate limiter to be disabled after sustained success, rate: %g", l.rate)
+	}
+}
@@ -0,0 +1,47 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+)
+
+type httpTransportContextKey struct{}
+
+// WithHTTPTransport returns a copy of ctx that makes the AWS clients configured with it send all
+// requests through the transport returned by wrap, which is passed the clients' own transport.
+// This allows acceptance tests to record and replay AWS API interactions.
+func WithHTTPTransport(ctx context.Context, wrap func(http.RoundTripper) http.RoundTripper) context.Context {
+	return context.WithValue(ctx, httpTransportContextKey{}, wrap)
+}
+
+// httpTransportWrapper returns the HTTP transport wrapper set by WithHTTPTransport, if any.
+func httpTransportWrapper(ctx context.Context) func(http.RoundTripper) http.RoundTripper {
+	wrap, _ := ctx.Value(httpTransportContextKey{}).(func(http.RoundTripper) http.RoundTripper)
+
+	return wrap
+}
+
+// wrapHTTPClient returns an HTTP client that sends requests through wrap around client's transport.
+func wrapHTTPClient(client *http.Client, wrap func(http.RoundTripper) http.RoundTripper) *http.Client {
+	transport := client.Transport
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	wrapped := *client
+	wrapped.Transport = wrap(transport)
+
+	return &wrapped
+}
+
+// httpClientTransport adapts an AWS SDK for Go v2 HTTP client to an http.RoundTripper.
+type httpClientTransport struct {
+	client awsv2.HTTPClient
+}
+
+func (t httpClientTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return t.client.Do(r)
+}
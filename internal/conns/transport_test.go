@@ -0,0 +1,75 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+)
+
+type countingTransport struct {
+	mu       sync.Mutex
+	next     http.RoundTripper
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests++
+	t.mu.Unlock()
+
+	return t.next.RoundTrip(r)
+}
+
+func TestConfigClientWithHTTPTransport(t *testing.T) {
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	transport := &countingTransport{}
+	ctx := WithHTTPTransport(context.Background(), func(next http.RoundTripper) http.RoundTripper {
+		transport.next = next
+
+		return transport
+	})
+
+	c := &Config{
+		AccessKey:               fakeaws.AccessKeyID,
+		EndpointURL:             server.URL,
+		Region:                  "us-west-2",
+		SecretKey:               fakeaws.SecretAccessKey,
+		SkipGetEC2Platforms:     true,
+		SkipRequestingAccountId: true,
+	}
+
+	meta, diags := c.Client(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	client := meta.(*AWSClient)
+
+	// Credentials validation uses the AWS SDK for Go v2.
+	if got, want := transport.requests, 1; got != want {
+		t.Fatalf("got %d requests after configuration, want %d", got, want)
+	}
+
+	if got, want := client.AccountID, fakeaws.AccountID; got != want {
+		t.Errorf("got account ID %q, want %q", got, want)
+	}
+
+	if got := client.regionalClients.awsConfig.ClientLogMode; got != 0 {
+		t.Errorf("got AWS SDK for Go v2 client log mode %d, want 0", got)
+	}
+
+	if _, err := client.SQSConn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := transport.requests, 2; got != want {
+		t.Fatalf("got %d requests, want %d", got, want)
+	}
+}
@@ -0,0 +1,744 @@
+package flex
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
+)
+
+// FieldKind overrides how an attribute's value is converted to and from its AWS SDK for Go struct field.
+type FieldKind int
+
+const (
+	// FieldKindDefault converts values according to the attribute's schema type and the field's Go type.
+	FieldKindDefault FieldKind = iota
+
+	// FieldKindJSON converts a TypeString JSON document to and from a *string (normalized on expand) or an aws.JSONValue field.
+	FieldKindJSON
+
+	// FieldKindTimestamp converts a TypeString timestamp to and from a *time.Time field.
+	// This is the default for *time.Time fields.
+	FieldKindTimestamp
+
+	// FieldKindNullableBool converts a nullable.TypeNullableBool attribute to and from a *bool field.
+	FieldKindNullableBool
+
+	// FieldKindNullableInt converts a nullable.TypeNullableInt attribute to and from an *int64 field.
+	FieldKindNullableInt
+)
+
+// Mapping overrides the conventional mapping between the attributes of a schema and the fields of an AWS SDK for Go struct.
+// Keys are attribute names.
+type Mapping map[string]FieldMapping
+
+// FieldMapping overrides the mapping of one attribute.
+type FieldMapping struct {
+	// Field is the name of the struct field.
+	// By default an attribute maps to the field whose name matches the attribute name ignoring case and underscores,
+	// e.g. "kms_key_id" maps to "KmsKeyId".
+	Field string
+
+	// Ignore excludes the attribute from the mapping, e.g. because it is handled by hand.
+	Ignore bool
+
+	// Kind overrides how the attribute's value is converted.
+	Kind FieldKind
+
+	// TimestampLayout is the layout of FieldKindTimestamp values. Defaults to time.RFC3339.
+	TimestampLayout string
+
+	// EnumValues, if set, are the only valid values of a string field, e.g. service.Enum_Values().
+	EnumValues []string
+
+	// Nested overrides the mapping of a nested block's attributes.
+	Nested Mapping
+}
+
+// Expand sets the fields of the AWS SDK for Go struct pointed to by apiObject from a Terraform configuration block.
+// Attributes map to fields by name convention, subject to the overrides in mapping.
+// Read-only attributes and attributes without a matching field are skipped.
+// Zero values other than false are treated as unset, as are empty nullable values.
+func Expand(s map[string]*schema.Schema, tfMap map[string]interface{}, apiObject interface{}, mapping Mapping) error {
+	v, err := structValue(apiObject)
+
+	if err != nil {
+		return err
+	}
+
+	return expandStruct(s, tfMap, v, mapping)
+}
+
+// ExpandResourceData sets the fields of the AWS SDK for Go struct pointed to by apiObject from a resource's root attributes.
+func ExpandResourceData(d *schema.ResourceData, s map[string]*schema.Schema, apiObject interface{}, mapping Mapping) error {
+	tfMap := make(map[string]interface{}, len(s))
+
+	for k := range s {
+		tfMap[k] = d.Get(k)
+	}
+
+	return Expand(s, tfMap, apiObject, mapping)
+}
+
+// Flatten returns the Terraform configuration block corresponding to the AWS SDK for Go struct pointed to by apiObject.
+// Fields with nil values are omitted.
+func Flatten(s map[string]*schema.Schema, apiObject interface{}, mapping Mapping) (map[string]interface{}, error) {
+	if v := reflect.ValueOf(apiObject); apiObject == nil || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, nil
+	}
+
+	v, err := structValue(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenStruct(s, v, mapping)
+}
+
+// FlattenResourceData sets a resource's root attributes from the AWS SDK for Go struct pointed to by apiObject.
+// Attributes whose fields have nil values are set to their zero value.
+func FlattenResourceData(d *schema.ResourceData, s map[string]*schema.Schema, apiObject interface{}, mapping Mapping) error {
+	v, err := structValue(apiObject)
+
+	if err != nil {
+		return err
+	}
+
+	tfMap, err := flattenStruct(s, v, mapping)
+
+	if err != nil {
+		return err
+	}
+
+	for _, k := range sortedAttributes(s) {
+		if mapping[k].Ignore {
+			continue
+		}
+
+		if _, ok := findField(v.Type(), k, mapping[k]); !ok {
+			continue
+		}
+
+		if err := d.Set(k, tfMap[k]); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func structValue(apiObject interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expected non-nil pointer to struct, got: %T", apiObject)
+	}
+
+	return v.Elem(), nil
+}
+
+func sortedAttributes(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+
+	for k := range s {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// findField returns the struct field that an attribute maps to.
+func findField(t reflect.Type, attribute string, fieldMapping FieldMapping) (reflect.StructField, bool) {
+	if fieldMapping.Field != "" {
+		return t.FieldByName(fieldMapping.Field)
+	}
+
+	name := strings.ReplaceAll(attribute, "_", "")
+
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.PkgPath == "" && strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func readOnly(attr *schema.Schema) bool {
+	return attr.Computed && !attr.Optional && !attr.Required
+}
+
+func expandStruct(s map[string]*schema.Schema, tfMap map[string]interface{}, v reflect.Value, mapping Mapping) error {
+	for _, k := range sortedAttributes(s) {
+		attr := s[k]
+		fieldMapping := mapping[k]
+
+		if fieldMapping.Ignore || readOnly(attr) {
+			continue
+		}
+
+		field, ok := findField(v.Type(), k, fieldMapping)
+
+		if !ok {
+			if fieldMapping.Field != "" {
+				return fmt.Errorf("%s: no field %s in %s", k, fieldMapping.Field, v.Type())
+			}
+
+			continue
+		}
+
+		raw, ok := tfMap[k]
+
+		if !ok || raw == nil {
+			continue
+		}
+
+		value, ok, err := expandValue(attr, fieldMapping, raw, field.Type)
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if !value.Type().AssignableTo(field.Type) {
+			return fmt.Errorf("%s: %w", k, errUnsupportedExpand(attr, field.Type))
+		}
+
+		v.FieldByIndex(field.Index).Set(value)
+	}
+
+	return nil
+}
+
+// expandValue converts a Terraform value to a value of the specified type.
+// The returned bool indicates whether the value is set.
+func expandValue(attr *schema.Schema, fieldMapping FieldMapping, raw interface{}, t reflect.Type) (reflect.Value, bool, error) {
+	switch fieldMapping.Kind {
+	case FieldKindNullableBool:
+		s, ok := raw.(string)
+
+		if !ok {
+			return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+		}
+
+		v, null, err := nullable.Bool(s).Value()
+
+		if err != nil || null {
+			return reflect.Value{}, false, err
+		}
+
+		return reflect.ValueOf(aws.Bool(v)), true, nil
+
+	case FieldKindNullableInt:
+		s, ok := raw.(string)
+
+		if !ok {
+			return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+		}
+
+		v, null, err := nullable.Int(s).Value()
+
+		if err != nil || null {
+			return reflect.Value{}, false, err
+		}
+
+		return reflect.ValueOf(aws.Int64(v)), true, nil
+
+	case FieldKindJSON:
+		v, ok := raw.(string)
+
+		if !ok {
+			return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+		}
+
+		if v == "" {
+			return reflect.Value{}, false, nil
+		}
+
+		if t == reflect.TypeOf(aws.JSONValue{}) {
+			var value aws.JSONValue
+
+			if err := json.Unmarshal([]byte(v), &value); err != nil {
+				return reflect.Value{}, false, err
+			}
+
+			return reflect.ValueOf(value), true, nil
+		}
+
+		v, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+
+		return reflect.ValueOf(aws.String(v)), true, nil
+	}
+
+	if t == reflect.TypeOf((*time.Time)(nil)) {
+		v, ok := raw.(string)
+
+		if !ok {
+			return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+		}
+
+		if v == "" {
+			return reflect.Value{}, false, nil
+		}
+
+		value, err := time.Parse(timestampLayout(fieldMapping), v)
+
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+
+		return reflect.ValueOf(aws.Time(value)), true, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		switch t.Elem().Kind() {
+		case reflect.String:
+			v, ok := raw.(string)
+
+			if !ok {
+				break
+			}
+
+			if v == "" {
+				return reflect.Value{}, false, nil
+			}
+
+			if err := checkEnum(fieldMapping, v); err != nil {
+				return reflect.Value{}, false, err
+			}
+
+			return reflect.ValueOf(aws.String(v)), true, nil
+
+		case reflect.Int64:
+			v, ok := raw.(int)
+
+			if !ok {
+				break
+			}
+
+			if v == 0 {
+				return reflect.Value{}, false, nil
+			}
+
+			return reflect.ValueOf(aws.Int64(int64(v))), true, nil
+
+		case reflect.Float64:
+			v, ok := raw.(float64)
+
+			if !ok {
+				break
+			}
+
+			if v == 0 {
+				return reflect.Value{}, false, nil
+			}
+
+			return reflect.ValueOf(aws.Float64(v)), true, nil
+
+		case reflect.Bool:
+			v, ok := raw.(bool)
+
+			if !ok {
+				break
+			}
+
+			return reflect.ValueOf(aws.Bool(v)), true, nil
+
+		case reflect.Struct:
+			tfList := listOf(raw)
+
+			if len(tfList) == 0 {
+				return reflect.Value{}, false, nil
+			}
+
+			return expandBlock(attr, fieldMapping, tfList[0], t.Elem())
+		}
+
+	case reflect.Slice:
+		tfList := listOf(raw)
+
+		if len(tfList) == 0 {
+			return reflect.Value{}, false, nil
+		}
+
+		value := reflect.MakeSlice(t, 0, len(tfList))
+		elemType := t.Elem()
+
+		if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
+			for _, tfMapRaw := range tfList {
+				elem, ok, err := expandBlock(attr, fieldMapping, tfMapRaw, elemType.Elem())
+
+				if err != nil {
+					return reflect.Value{}, false, err
+				}
+
+				if ok {
+					value = reflect.Append(value, elem)
+				}
+			}
+
+			return value, true, nil
+		}
+
+		elemAttr, ok := attr.Elem.(*schema.Schema)
+
+		if !ok {
+			break
+		}
+
+		for _, raw := range tfList {
+			elem, ok, err := expandValue(elemAttr, FieldMapping{EnumValues: fieldMapping.EnumValues}, raw, elemType)
+
+			if err != nil {
+				return reflect.Value{}, false, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			if !elem.Type().AssignableTo(elemType) {
+				return reflect.Value{}, false, errUnsupportedExpand(elemAttr, elemType)
+			}
+
+			value = reflect.Append(value, elem)
+		}
+
+		return value, true, nil
+
+	case reflect.Map:
+		tfMap, ok := raw.(map[string]interface{})
+
+		if !ok || t.Key().Kind() != reflect.String {
+			break
+		}
+
+		if len(tfMap) == 0 {
+			return reflect.Value{}, false, nil
+		}
+
+		elemAttr, ok := attr.Elem.(*schema.Schema)
+
+		if !ok {
+			elemAttr = &schema.Schema{Type: schema.TypeString}
+		}
+
+		value := reflect.MakeMapWithSize(t, len(tfMap))
+
+		for k, raw := range tfMap {
+			// Unlike other string values, empty map values are set.
+			if v, ok := raw.(string); ok && t.Elem() == reflect.TypeOf(aws.String("")) {
+				value.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(aws.String(v)))
+				continue
+			}
+
+			elem, ok, err := expandValue(elemAttr, FieldMapping{}, raw, t.Elem())
+
+			if err != nil {
+				return reflect.Value{}, false, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			if !elem.Type().AssignableTo(t.Elem()) {
+				return reflect.Value{}, false, errUnsupportedExpand(elemAttr, t.Elem())
+			}
+
+			value.SetMapIndex(reflect.ValueOf(k), elem)
+		}
+
+		return value, true, nil
+	}
+
+	return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+}
+
+// errUnsupportedExpand returns the error for a Terraform value that can't be converted to the specified type,
+// e.g. because a field mapping's Kind doesn't match the attribute's schema type.
+func errUnsupportedExpand(attr *schema.Schema, t reflect.Type) error {
+	return fmt.Errorf("unsupported conversion from %s to %s", attr.Type, t)
+}
+
+// expandBlock converts a nested Terraform configuration block to a pointer to a struct of the specified type.
+func expandBlock(attr *schema.Schema, fieldMapping FieldMapping, raw interface{}, t reflect.Type) (reflect.Value, bool, error) {
+	elem, ok := attr.Elem.(*schema.Resource)
+
+	if !ok {
+		return reflect.Value{}, false, errUnsupportedExpand(attr, t)
+	}
+
+	// An empty block is represented by a nil map.
+	tfMap, _ := raw.(map[string]interface{})
+	value := reflect.New(t)
+
+	if err := expandStruct(elem.Schema, tfMap, value.Elem(), fieldMapping.Nested); err != nil {
+		return reflect.Value{}, false, err
+	}
+
+	return value, true, nil
+}
+
+func flattenStruct(s map[string]*schema.Schema, v reflect.Value, mapping Mapping) (map[string]interface{}, error) {
+	tfMap := map[string]interface{}{}
+
+	for _, k := range sortedAttributes(s) {
+		attr := s[k]
+		fieldMapping := mapping[k]
+
+		if fieldMapping.Ignore {
+			continue
+		}
+
+		field, ok := findField(v.Type(), k, fieldMapping)
+
+		if !ok {
+			if fieldMapping.Field != "" {
+				return nil, fmt.Errorf("%s: no field %s in %s", k, fieldMapping.Field, v.Type())
+			}
+
+			continue
+		}
+
+		value, ok, err := flattenValue(attr, fieldMapping, v.FieldByIndex(field.Index))
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		if ok {
+			tfMap[k] = value
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenValue converts a value to a Terraform value.
+// The returned bool indicates whether the value is set.
+func flattenValue(attr *schema.Schema, fieldMapping FieldMapping, v reflect.Value) (interface{}, bool, error) {
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil() {
+		return nil, false, nil
+	}
+
+	switch fieldMapping.Kind {
+	case FieldKindNullableBool:
+		if !isPtrTo(v, reflect.Bool) {
+			return nil, false, errUnsupportedFlatten(attr, v.Type())
+		}
+
+		return string(nullable.NewBool(v.Elem().Bool())), true, nil
+
+	case FieldKindNullableInt:
+		if !isPtrTo(v, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64) {
+			return nil, false, errUnsupportedFlatten(attr, v.Type())
+		}
+
+		return strconv.FormatInt(v.Elem().Int(), 10), true, nil
+
+	case FieldKindJSON:
+		if value, ok := v.Interface().(aws.JSONValue); ok {
+			b, err := json.Marshal(value)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return string(b), true, nil
+		}
+
+		if !isPtrTo(v, reflect.String) {
+			return nil, false, errUnsupportedFlatten(attr, v.Type())
+		}
+
+		return v.Elem().String(), true, nil
+	}
+
+	if value, ok := v.Interface().(*time.Time); ok {
+		return value.Format(timestampLayout(fieldMapping)), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		switch v.Elem().Kind() {
+		case reflect.String:
+			return v.Elem().String(), true, nil
+
+		case reflect.Int64:
+			return int(v.Elem().Int()), true, nil
+
+		case reflect.Float64:
+			return v.Elem().Float(), true, nil
+
+		case reflect.Bool:
+			return v.Elem().Bool(), true, nil
+
+		case reflect.Struct:
+			tfMap, err := flattenBlock(attr, fieldMapping, v)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+
+	case reflect.Slice:
+		tfList := make([]interface{}, 0, v.Len())
+		elemType := v.Type().Elem()
+
+		if elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct {
+			for i := 0; i < v.Len(); i++ {
+				if v.Index(i).IsNil() {
+					continue
+				}
+
+				tfMap, err := flattenBlock(attr, fieldMapping, v.Index(i))
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				tfList = append(tfList, tfMap)
+			}
+
+			return tfList, true, nil
+		}
+
+		elemAttr, ok := attr.Elem.(*schema.Schema)
+
+		if !ok {
+			break
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			elem, ok, err := flattenValue(elemAttr, FieldMapping{}, v.Index(i))
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfList = append(tfList, elem)
+			}
+		}
+
+		return tfList, true, nil
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		elemAttr, ok := attr.Elem.(*schema.Schema)
+
+		if !ok {
+			elemAttr = &schema.Schema{Type: schema.TypeString}
+		}
+
+		tfMap := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			elem, ok, err := flattenValue(elemAttr, FieldMapping{}, iter.Value())
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				tfMap[iter.Key().String()] = elem
+			}
+		}
+
+		return tfMap, true, nil
+	}
+
+	return nil, false, errUnsupportedFlatten(attr, v.Type())
+}
+
+// errUnsupportedFlatten returns the error for a value of the specified type that can't be converted to a Terraform value,
+// e.g. because a field mapping's Kind doesn't match the field's type.
+func errUnsupportedFlatten(attr *schema.Schema, t reflect.Type) error {
+	return fmt.Errorf("unsupported conversion from %s to %s", t, attr.Type)
+}
+
+// isPtrTo returns whether v is a pointer to a value of one of the specified kinds.
+func isPtrTo(v reflect.Value, kinds ...reflect.Kind) bool {
+	if v.Kind() != reflect.Ptr {
+		return false
+	}
+
+	for _, kind := range kinds {
+		if v.Elem().Kind() == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenBlock converts a pointer to a struct to a nested Terraform configuration block.
+func flattenBlock(attr *schema.Schema, fieldMapping FieldMapping, v reflect.Value) (map[string]interface{}, error) {
+	elem, ok := attr.Elem.(*schema.Resource)
+
+	if !ok {
+		return nil, errUnsupportedFlatten(attr, v.Type())
+	}
+
+	return flattenStruct(elem.Schema, v.Elem(), fieldMapping.Nested)
+}
+
+// listOf returns the elements of a TypeList or TypeSet value.
+func listOf(raw interface{}) []interface{} {
+	switch v := raw.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+func timestampLayout(fieldMapping FieldMapping) string {
+	if fieldMapping.TimestampLayout != "" {
+		return fieldMapping.TimestampLayout
+	}
+
+	return time.RFC3339
+}
+
+func checkEnum(fieldMapping FieldMapping, v string) error {
+	if len(fieldMapping.EnumValues) == 0 {
+		return nil
+	}
+
+	for _, enumValue := range fieldMapping.EnumValues {
+		if v == enumValue {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("expected one of %s, got: %s", strings.Join(fieldMapping.EnumValues, ", "), v)
+}
@@ -0,0 +1,299 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
+)
+
+type testAutoNested struct {
+	_ struct{} `type:"structure"`
+
+	Name    *string
+	Port    *int64
+	Weights []*int64
+}
+
+type testAutoInput struct {
+	_ struct{} `type:"structure"`
+
+	CreatedAt   *time.Time
+	Document    aws.JSONValue
+	Enabled     *bool
+	KmsKeyId    *string
+	Labels      map[string]*string
+	Listener    *testAutoNested
+	Listeners   []*testAutoNested
+	Mode        *string
+	PolicyText  *string
+	Ratio       *float64
+	Retention   *int64
+	SubnetIds   []*string
+	Unmapped    *string
+	VersionFlag *bool
+}
+
+func testAutoSchema() map[string]*schema.Schema {
+	nested := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":    {Type: schema.TypeString, Optional: true},
+			"port":    {Type: schema.TypeInt, Optional: true},
+			"weights": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+		},
+	}
+
+	return map[string]*schema.Schema{
+		"arn":          {Type: schema.TypeString, Computed: true},
+		"created_at":   {Type: schema.TypeString, Optional: true},
+		"document":     {Type: schema.TypeString, Optional: true},
+		"enabled":      {Type: schema.TypeBool, Optional: true},
+		"kms_key_id":   {Type: schema.TypeString, Optional: true},
+		"labels":       {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"listener":     {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: nested},
+		"listeners":    {Type: schema.TypeSet, Optional: true, Elem: nested},
+		"mode":         {Type: schema.TypeString, Optional: true},
+		"policy":       {Type: schema.TypeString, Optional: true},
+		"ratio":        {Type: schema.TypeFloat, Optional: true},
+		"retention":    {Type: nullable.TypeNullableInt, Optional: true},
+		"subnet_ids":   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"version_flag": {Type: nullable.TypeNullableBool, Optional: true},
+	}
+}
+
+func testAutoMapping() Mapping {
+	return Mapping{
+		"document":     {Kind: FieldKindJSON},
+		"mode":         {EnumValues: []string{"FAST", "SLOW"}},
+		"policy":       {Field: "PolicyText", Kind: FieldKindJSON},
+		"retention":    {Kind: FieldKindNullableInt},
+		"version_flag": {Kind: FieldKindNullableBool},
+	}
+}
+
+func TestExpandResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testAutoSchema(), map[string]interface{}{
+		"created_at": "2022-03-01T10:00:00Z",
+		"document":   `{"b": 1, "a": [true]}`,
+		"enabled":    true,
+		"kms_key_id": "key",
+		"labels":     map[string]interface{}{"k1": "v1", "k2": ""},
+		"listener": []interface{}{
+			map[string]interface{}{"name": "main", "port": 443, "weights": []interface{}{1, 2}},
+		},
+		"mode":         "FAST",
+		"policy":       `{"Version": "2012-10-17"}`,
+		"retention":    "0",
+		"subnet_ids":   []interface{}{"subnet-1"},
+		"version_flag": "",
+	})
+
+	got := &testAutoInput{}
+
+	if err := ExpandResourceData(d, testAutoSchema(), got, testAutoMapping()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &testAutoInput{
+		CreatedAt: aws.Time(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Document:  aws.JSONValue{"a": []interface{}{true}, "b": float64(1)},
+		Enabled:   aws.Bool(true),
+		KmsKeyId:  aws.String("key"),
+		Labels:    map[string]*string{"k1": aws.String("v1"), "k2": aws.String("")},
+		Listener: &testAutoNested{
+			Name:    aws.String("main"),
+			Port:    aws.Int64(443),
+			Weights: []*int64{aws.Int64(1), aws.Int64(2)},
+		},
+		Mode:       aws.String("FAST"),
+		PolicyText: aws.String(`{"Version":"2012-10-17"}`),
+		Retention:  aws.Int64(0),
+		SubnetIds:  []*string{aws.String("subnet-1")},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %s\nexpected %s", awsutil.Prettify(got), awsutil.Prettify(expected))
+	}
+}
+
+func TestExpandInvalidEnum(t *testing.T) {
+	err := Expand(testAutoSchema(), map[string]interface{}{"mode": "MEDIUM"}, &testAutoInput{}, testAutoMapping())
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+}
+
+func TestExpandInvalidField(t *testing.T) {
+	err := Expand(testAutoSchema(), map[string]interface{}{"mode": "FAST"}, &testAutoInput{}, Mapping{"mode": {Field: "Missing"}})
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+}
+
+func TestExpandMismatchedKind(t *testing.T) {
+	// "enabled" is a TypeBool, not a nullable bool.
+	err := Expand(testAutoSchema(), map[string]interface{}{"enabled": true}, &testAutoInput{}, Mapping{"enabled": {Kind: FieldKindNullableBool}})
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+}
+
+func TestExpandMismatchedFieldType(t *testing.T) {
+	// "ratio" is a TypeFloat, mapped to an integer field.
+	err := Expand(testAutoSchema(), map[string]interface{}{"ratio": 0.5}, &testAutoInput{}, Mapping{"ratio": {Field: "Retention"}})
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+}
+
+func TestExpandMismatchedKindFieldType(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Input   map[string]interface{}
+		Mapping Mapping
+	}{
+		{
+			Name:    "nullable bool to string",
+			Input:   map[string]interface{}{"version_flag": "true"},
+			Mapping: Mapping{"version_flag": {Field: "KmsKeyId", Kind: FieldKindNullableBool}},
+		},
+		{
+			Name:    "nullable int to bool",
+			Input:   map[string]interface{}{"retention": "1"},
+			Mapping: Mapping{"retention": {Field: "Enabled", Kind: FieldKindNullableInt}},
+		},
+		{
+			Name:    "JSON to int",
+			Input:   map[string]interface{}{"document": `{"a": 1}`},
+			Mapping: Mapping{"document": {Field: "Retention", Kind: FieldKindJSON}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := Expand(testAutoSchema(), testCase.Input, &testAutoInput{}, testCase.Mapping)
+
+			if err == nil {
+				t.Fatalf("expected error, got no error")
+			}
+		})
+	}
+}
+
+func TestFlattenMismatchedKindFieldType(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Input   *testAutoInput
+		Mapping Mapping
+	}{
+		{
+			Name:    "string to nullable bool",
+			Input:   &testAutoInput{KmsKeyId: aws.String("key")},
+			Mapping: Mapping{"version_flag": {Field: "KmsKeyId", Kind: FieldKindNullableBool}},
+		},
+		{
+			Name:    "bool to nullable int",
+			Input:   &testAutoInput{Enabled: aws.Bool(true)},
+			Mapping: Mapping{"retention": {Field: "Enabled", Kind: FieldKindNullableInt}},
+		},
+		{
+			Name:    "int to JSON",
+			Input:   &testAutoInput{Retention: aws.Int64(1)},
+			Mapping: Mapping{"document": {Field: "Retention", Kind: FieldKindJSON}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := Flatten(testAutoSchema(), testCase.Input, testCase.Mapping)
+
+			if err == nil {
+				t.Fatalf("expected error, got no error")
+			}
+		})
+	}
+}
+
+func TestExpandNotPointer(t *testing.T) {
+	err := Expand(testAutoSchema(), map[string]interface{}{}, testAutoInput{}, nil)
+
+	if err == nil {
+		t.Fatalf("expected error, got no error")
+	}
+}
+
+func TestFlattenNil(t *testing.T) {
+	got, err := Flatten(testAutoSchema(), (*testAutoInput)(nil), nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("got %v, expected nil", got)
+	}
+}
+
+func TestAutoRoundTrip(t *testing.T) {
+	apiObject := &testAutoInput{
+		CreatedAt: aws.Time(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Document:  aws.JSONValue{"a": []interface{}{true}},
+		Enabled:   aws.Bool(false),
+		KmsKeyId:  aws.String("key"),
+		Labels:    map[string]*string{"k1": aws.String("v1")},
+		Listeners: []*testAutoNested{
+			{Name: aws.String("one"), Port: aws.Int64(80)},
+			{Name: aws.String("two"), Weights: []*int64{aws.Int64(3)}},
+		},
+		Mode:        aws.String("SLOW"),
+		PolicyText:  aws.String(`{"Version":"2012-10-17"}`),
+		Ratio:       aws.Float64(0.5),
+		Retention:   aws.Int64(0),
+		SubnetIds:   []*string{aws.String("subnet-1"), aws.String("subnet-2")},
+		Unmapped:    aws.String("ignored"),
+		VersionFlag: aws.Bool(false),
+	}
+
+	d := schema.TestResourceDataRaw(t, testAutoSchema(), map[string]interface{}{})
+
+	if err := FlattenResourceData(d, testAutoSchema(), apiObject, testAutoMapping()); err != nil {
+		t.Fatalf("unexpected error flattening: %s", err)
+	}
+
+	if got, expected := d.Get("retention").(string), "0"; got != expected {
+		t.Errorf("got retention %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.Get("listeners").(*schema.Set).Len(), 2; got != expected {
+		t.Errorf("got %d listeners, expected %d", got, expected)
+	}
+
+	got := &testAutoInput{}
+
+	if err := ExpandResourceData(d, testAutoSchema(), got, testAutoMapping()); err != nil {
+		t.Fatalf("unexpected error expanding: %s", err)
+	}
+
+	// Unmapped fields don't round trip.
+	apiObject.Unmapped = nil
+
+	// Sets are unordered.
+	if len(got.Listeners) == 2 && aws.StringValue(got.Listeners[0].Name) == "two" {
+		got.Listeners[0], got.Listeners[1] = got.Listeners[1], got.Listeners[0]
+	}
+	if len(got.SubnetIds) == 2 && aws.StringValue(got.SubnetIds[0]) == "subnet-2" {
+		got.SubnetIds[0], got.SubnetIds[1] = got.SubnetIds[1], got.SubnetIds[0]
+	}
+
+	if !reflect.DeepEqual(got, apiObject) {
+		t.Errorf("got %s\nexpected %s", awsutil.Prettify(got), awsutil.Prettify(apiObject))
+	}
+}
@@ -15,8 +15,11 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	texttemplate "text/template"
+	"unicode"
 
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
 )
 
 const (
@@ -27,6 +30,21 @@ var (
 	listOps   = flag.String("ListOps", "", "ListOps")
 	paginator = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export    = flag.Bool("Export", false, "whether to export the list functions")
+
+	dataSource                = flag.String("DataSource", "", "name of a plural data source to generate instead of list functions, e.g. Queues")
+	dataSourceDescription     = flag.String("DataSourceDescription", "", "human-readable name of the listed resource, e.g. SQS Queue")
+	dataSourcePlural          = flag.String("DataSourcePlural", "", "plural human-readable name of the listed resource, default <DataSourceDescription>s")
+	dataSourceListOp          = flag.String("DataSourceListOp", "", "List/Describe operation used by the data source")
+	dataSourceItems           = flag.String("DataSourceItems", "", "name of the operation output field holding the listed items")
+	dataSourceIDField         = flag.String("DataSourceIDField", "", "name of the item field set in ids, or <function>(<field>) to convert it")
+	dataSourceARNField        = flag.String("DataSourceARNField", "", "name of the item field set in arns, or <function>(<field>) to convert it")
+	dataSourceARNService      = flag.String("DataSourceARNService", "", "service of the ARNs set in arns, built from names, if the items have no ARN field")
+	dataSourceNameField       = flag.String("DataSourceNameField", "", "name of the item field set in names, or <function>(<field>) to convert it")
+	dataSourceArgs            = flag.String("DataSourceArgs", "", "comma-separated list of <attribute>:<input field> string arguments")
+	dataSourceFilters         = flag.String("DataSourceFilters", "", "name of the namevaluesfilters conversion function for the input Filters field, e.g. Ec2Filters")
+	dataSourceTagFilters      = flag.Bool("DataSourceTagFilters", false, "whether tags are matched using tag:<key> filters")
+	dataSourceListTagsIDField = flag.String("DataSourceListTagsIDField", "", "name of the item field passed to ListTags when matching tags")
+	dataSourceTagsField       = flag.String("DataSourceTagsField", "", "name of the item field holding tags when matching tags")
 )
 
 func usage() {
@@ -69,6 +87,12 @@ func main() {
 		Paginator:      *paginator,
 	}
 
+	if *dataSource != "" {
+		generateDataSource(servicePackage, awsService)
+
+		return
+	}
+
 	functions := strings.Split(templateData.ListOps, ",")
 	sort.Strings(functions)
 
@@ -168,38 +192,32 @@ type FuncSpec struct {
 	Paginator  string
 }
 
-func (g *Generator) generateFunction(functionName string, export bool) {
-	var function *ast.FuncDecl
-
-	// TODO: check if a Pages() function has been defined
+func (g *Generator) findFunction(functionName string) *ast.FuncDecl {
 	for _, file := range g.pkg.files {
 		if file.file != nil {
 			for _, decl := range file.file.Decls {
 				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
 					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
+						return funcDecl
 					}
 				}
 			}
-			if function != nil {
-				break
-			}
 		}
 	}
 
-	if function == nil {
-		log.Fatalf("function \"%s\" not found", functionName)
-	}
+	return nil
+}
 
-	funcName := function.Name.Name
+func (g *Generator) generateFunction(functionName string, export bool) {
+	// TODO: check if a Pages() function has been defined
+	function := g.findFunction(functionName)
 
-	if !export {
-		funcName = fmt.Sprintf("%s%s", strings.ToLower(funcName[0:1]), funcName[1:])
+	if function == nil {
+		log.Fatalf("function \"%s\" not found", functionName)
 	}
 
 	funcSpec := FuncSpec{
-		Name:       fixSomeInitialisms(funcName),
+		Name:       listFunctionName(function.Name.Name, export),
 		AWSName:    function.Name.Name,
 		RecvType:   g.expandTypeField(function.Recv),
 		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
@@ -213,6 +231,15 @@ func (g *Generator) generateFunction(functionName string, export bool) {
 	}
 }
 
+// listFunctionName returns the name of the generated list function for an AWS Go SDK function.
+func listFunctionName(functionName string, export bool) string {
+	if !export {
+		functionName = fmt.Sprintf("%s%s", strings.ToLower(functionName[0:1]), functionName[1:])
+	}
+
+	return fixSomeInitialisms(functionName)
+}
+
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
 	typeValue := field.List[0].Type
 	if star, ok := typeValue.(*ast.StarExpr); ok {
@@ -268,6 +295,411 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 }
 `
 
+type DataSourceArg struct {
+	Attribute string
+	Field     string
+}
+
+type DataSourceSpec struct {
+	HeaderInfo
+
+	Name        string
+	Description string
+	Plural      string
+	Conn        string
+
+	InputType  string
+	OutputType string
+	PagesFunc  string
+	SDKPages   bool
+	Items      string
+
+	ID       DataSourceValue
+	ARN      DataSourceValue
+	ItemName DataSourceValue
+
+	ARNService string
+
+	Args       []DataSourceArg
+	Filters    string
+	TagFilters bool
+	ListTagsID string
+	TagsField  string
+}
+
+func (spec DataSourceSpec) Tags() bool {
+	return spec.TagFilters || spec.ListTagsID != "" || spec.TagsField != ""
+}
+
+func (spec DataSourceSpec) MatchTags() bool {
+	return !spec.TagFilters && spec.Tags()
+}
+
+func (spec DataSourceSpec) Convert() bool {
+	return spec.ID.Func != "" || spec.ARN.Func != "" || spec.ItemName.Func != ""
+}
+
+// DataSourceValue is the value of an item set in the ids, arns or names attribute.
+type DataSourceValue struct {
+	Field string // Go expression for the item field.
+	Func  string // Optional function converting the field's value.
+}
+
+// itemValue returns the Go expression for an item field, with "." denoting the item itself.
+func itemValue(field string) string {
+	if field == "" {
+		return ""
+	}
+
+	if field == "." {
+		return "v"
+	}
+
+	return fmt.Sprintf("v.%s", field)
+}
+
+// dataSourceValue parses an item field flag, either <field> or <function>(<field>).
+// The function must have the signature func(string) (string, error).
+func dataSourceValue(flagName, v string) DataSourceValue {
+	i := strings.Index(v, "(")
+
+	if i == -1 {
+		return DataSourceValue{Field: itemValue(v)}
+	}
+
+	if i == 0 || !strings.HasSuffix(v, ")") {
+		log.Fatalf("-%s (%s) must be of the form <field> or <function>(<field>)", flagName, v)
+	}
+
+	return DataSourceValue{
+		Field: itemValue(v[i+1 : len(v)-1]),
+		Func:  v[:i],
+	}
+}
+
+func generateDataSource(servicePackage, awsService string) {
+	if *dataSourceListOp == "" || *dataSourceItems == "" || *dataSourceDescription == "" {
+		log.Fatal("-DataSourceListOp, -DataSourceItems and -DataSourceDescription are required with -DataSource")
+	}
+
+	if *dataSourceIDField == "" || *dataSourceNameField == "" {
+		log.Fatal("-DataSourceIDField and -DataSourceNameField are required with -DataSource")
+	}
+
+	if (*dataSourceARNField == "") == (*dataSourceARNService == "") {
+		log.Fatal("exactly one of -DataSourceARNField and -DataSourceARNService is required with -DataSource")
+	}
+
+	if *dataSourceTagFilters && *dataSourceFilters == "" {
+		log.Fatal("-DataSourceTagFilters requires -DataSourceFilters")
+	}
+
+	awsServiceUpper, err := awsServiceNameUpper(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	g := Generator{}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", awsService)
+	g.parsePackage(sourcePackage)
+
+	function := g.findFunction(*dataSourceListOp)
+
+	if function == nil {
+		log.Fatalf("function \"%s\" not found", *dataSourceListOp)
+	}
+
+	spec := DataSourceSpec{
+		HeaderInfo: HeaderInfo{
+			Parameters:         strings.Join(os.Args[1:], " "),
+			DestinationPackage: servicePackage,
+			SourcePackage:      sourcePackage,
+		},
+		Name:        *dataSource,
+		Description: *dataSourceDescription,
+		Plural:      *dataSourcePlural,
+		Conn:        fmt.Sprintf("%sConn", awsServiceUpper),
+		InputType:   strings.TrimPrefix(g.expandTypeField(function.Type.Params), "*"),
+		OutputType:  g.expandTypeField(function.Type.Results),
+		Items:       *dataSourceItems,
+		ID:          dataSourceValue("DataSourceIDField", *dataSourceIDField),
+		ARN:         dataSourceValue("DataSourceARNField", *dataSourceARNField),
+		ARNService:  *dataSourceARNService,
+		ItemName:    dataSourceValue("DataSourceNameField", *dataSourceNameField),
+		Filters:     *dataSourceFilters,
+		TagFilters:  *dataSourceTagFilters,
+		ListTagsID:  itemValue(*dataSourceListTagsIDField),
+		TagsField:   *dataSourceTagsField,
+	}
+
+	if spec.Plural == "" {
+		spec.Plural = fmt.Sprintf("%ss", spec.Description)
+	}
+
+	// Use the AWS Go SDK paginated function if defined, otherwise the one generated by -ListOps.
+	if g.findFunction(fmt.Sprintf("%sPages", *dataSourceListOp)) != nil {
+		spec.SDKPages = true
+		spec.PagesFunc = fmt.Sprintf("conn.%sPages", *dataSourceListOp)
+	} else {
+		spec.PagesFunc = fmt.Sprintf("%sPages", listFunctionName(*dataSourceListOp, *export))
+	}
+
+	if *dataSourceArgs != "" {
+		for _, arg := range strings.Split(*dataSourceArgs, ",") {
+			parts := strings.Split(arg, ":")
+
+			if len(parts) != 2 {
+				log.Fatalf("argument (%s) must be of the form <attribute>:<input field>", arg)
+			}
+
+			spec.Args = append(spec.Args, DataSourceArg{
+				Attribute: parts[0],
+				Field:     parts[1],
+			})
+		}
+
+		sort.Slice(spec.Args, func(i, j int) bool {
+			return spec.Args[i].Attribute < spec.Args[j].Attribute
+		})
+	}
+
+	var buf bytes.Buffer
+
+	tmpl := texttemplate.Must(texttemplate.New("datasource").Parse(dataSourceTemplate))
+
+	if err := tmpl.Execute(&buf, spec); err != nil {
+		log.Fatalf("error writing data source \"%s\": %s", spec.Name, err)
+	}
+
+	filename := fmt.Sprintf("%s_data_source_gen.go", snakeCase(spec.Name))
+
+	// Also removes any imports unused by the data source.
+	src, err := imports.Process(filename, buf.Bytes(), nil)
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+const dataSourceTemplate = `// Code generated by "internal/generate/listpages/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSource{{ .Name }}() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSource{{ .Name }}Read,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+{{- if .Filters }}
+			"filter": namevaluesfilters.Schema(),
+{{- end }}
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+{{- range .Args }}
+			"{{ .Attribute }}": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+{{- end }}
+{{- if .Tags }}
+			"tags": tftags.TagsSchema(),
+{{- end }}
+		},
+	}
+}
+
+func dataSource{{ .Name }}Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .Conn }}
+{{- if .Tags }}
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+{{- end }}
+
+	input := &{{ .InputType }}{}
+{{- range .Args }}
+
+	if v, ok := d.GetOk("{{ .Attribute }}"); ok {
+		input.{{ .Field }} = aws.String(v.(string))
+	}
+{{- end }}
+{{- if .Filters }}
+
+	filters := namevaluesfilters.New(d.Get("filter").(*schema.Set))
+{{- if .TagFilters }}
+	filters.Add(namevaluesfilters.Tags(tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()))
+{{- end }}
+
+	if len(filters) > 0 {
+		input.Filters = filters.{{ .Filters }}()
+	}
+{{- end }}
+{{- if .MatchTags }}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+{{- end }}
+
+	var arns, ids, names []string
+{{- if or .ListTagsID .Convert }}
+	var itemErr error
+{{- end }}
+
+	err := {{ .PagesFunc }}({{ if not .SDKPages }}conn, {{ end }}input, func(page {{ .OutputType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .Items }} {
+			if v == nil {
+				continue
+			}
+{{- if .MatchTags }}
+
+			if len(tagsToMatch) > 0 {
+{{- if .ListTagsID }}
+				tags, err := ListTags(conn, aws.StringValue({{ .ListTagsID }}))
+
+				if err != nil {
+					itemErr = fmt.Errorf("error listing tags for {{ .Description }} (%s): %w", aws.StringValue({{ .ListTagsID }}), err)
+
+					return false
+				}
+{{- else }}
+				tags := KeyValueTags(v.{{ .TagsField }})
+{{- end }}
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+{{- end }}
+{{ with .ID }}
+{{- if .Func }}
+			id, err := {{ .Func }}(aws.StringValue({{ .Field }}))
+
+			if err != nil {
+				itemErr = fmt.Errorf("error reading {{ $.Description }} (%s) ID: %w", aws.StringValue({{ .Field }}), err)
+
+				return false
+			}
+{{ else }}
+			id := aws.StringValue({{ .Field }})
+{{- end }}
+{{- end }}
+{{- with .ItemName }}
+{{- if .Func }}
+
+			name, err := {{ .Func }}(aws.StringValue({{ .Field }}))
+
+			if err != nil {
+				itemErr = fmt.Errorf("error reading {{ $.Description }} (%s) name: %w", aws.StringValue({{ .Field }}), err)
+
+				return false
+			}
+{{ else }}
+			name := aws.StringValue({{ .Field }})
+{{- end }}
+{{- end }}
+{{- if .ARNService }}
+
+			arn := arn.ARN{
+				Partition: meta.(*conns.AWSClient).Partition,
+				Service:   "{{ .ARNService }}",
+				Region:    meta.(*conns.AWSClient).Region,
+				AccountID: meta.(*conns.AWSClient).AccountID,
+				Resource:  name,
+			}.String()
+{{- else }}
+{{- with .ARN }}
+{{- if .Func }}
+
+			arn, err := {{ .Func }}(aws.StringValue({{ .Field }}))
+
+			if err != nil {
+				itemErr = fmt.Errorf("error reading {{ $.Description }} (%s) ARN: %w", aws.StringValue({{ .Field }}), err)
+
+				return false
+			}
+{{ else }}
+			arn := aws.StringValue({{ .Field }})
+{{- end }}
+{{- end }}
+{{- end }}
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading {{ .Plural }}: %w", err)
+	}
+{{- if or .ListTagsID .Convert }}
+
+	if itemErr != nil {
+		return itemErr
+	}
+{{- end }}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
+`
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
@@ -1,30 +1,32 @@
-package namevaluesfilters
+package namevaluesfilters_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
 )
 
 func TestNameValuesFiltersEc2Tags(t *testing.T) {
 	testCases := []struct {
 		name    string
-		filters NameValuesFilters
+		filters namevaluesfilters.NameValuesFilters
 		want    map[string][]string
 	}{
 		{
 			name:    "nil",
-			filters: Ec2Tags(nil),
+			filters: namevaluesfilters.Ec2Tags(nil),
 			want:    map[string][]string{},
 		},
 		{
 			name:    "nil",
-			filters: Ec2Tags(map[string]string{}),
+			filters: namevaluesfilters.Ec2Tags(map[string]string{}),
 			want:    map[string][]string{},
 		},
 		{
 			name: "tags",
-			filters: Ec2Tags(map[string]string{
+			filters: namevaluesfilters.Ec2Tags(map[string]string{
 				"Name":    acctest.ResourcePrefix,
 				"Purpose": "testing",
 			}),
@@ -39,7 +41,9 @@ func TestNameValuesFiltersEc2Tags(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			got := testCase.filters.Map()
 
-			testNameValuesFiltersVerifyMap(t, got, testCase.want)
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
 		})
 	}
 }
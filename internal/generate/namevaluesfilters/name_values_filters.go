@@ -1,6 +1,8 @@
 package namevaluesfilters
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -98,6 +100,18 @@ func New(i interface{}) NameValuesFilters {
 	return make(NameValuesFilters).Add(i)
 }
 
+// Tags creates NameValuesFilters from a map of keyvalue tags.
+// Each tag is converted to a filter named "tag:<key>", the format used by services supporting tag filters.
+func Tags(tags map[string]string) NameValuesFilters {
+	m := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		m[fmt.Sprintf("tag:%s", k)] = v
+	}
+
+	return New(m)
+}
+
 // Schema returns a *schema.Schema that represents a set of custom filtering criteria
 // that a user can specify as input to a data source.
 // It is conventional for an attribute of this type to be included as a top-level attribute called "filter".
@@ -168,3 +168,17 @@ func testNameValuesFiltersHashSet(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(m["name"].(string))
 }
+
+func TestNameValuesFiltersTags(t *testing.T) {
+	got := Tags(map[string]string{
+		"Name":        "example",
+		"Environment": "",
+	}).Map()
+	want := map[string][]string{
+		"tag:Name": {"example"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
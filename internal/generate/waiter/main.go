@@ -0,0 +1,398 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"golang.org/x/tools/imports"
+)
+
+const (
+	statusFilename = "status_gen.go"
+	waitFilename   = "wait_gen.go"
+)
+
+var (
+	declarations = flag.String("Declarations", "waiters.hcl", "name of the waiter declarations file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Config is the top-level waiter declarations file.
+type Config struct {
+	ConnType string   `hcl:"conn_type"`
+	Context  bool     `hcl:"context,optional"`
+	Export   bool     `hcl:"export,optional"`
+	Statuses []Status `hcl:"status,block"`
+	Waiters  []Waiter `hcl:"waiter,block"`
+}
+
+// Status declares a resource.StateRefreshFunc built on a finder function.
+type Status struct {
+	Name          string   `hcl:"name,label"`
+	Finder        string   `hcl:"finder"`
+	Args          []string `hcl:"args"`
+	OutputType    string   `hcl:"output_type"`
+	StatusField   string   `hcl:"status_field"`
+	NotFoundError bool     `hcl:"not_found_error,optional"`
+}
+
+// Waiter declares a resource.StateChangeConf waiter using a declared status.
+type Waiter struct {
+	Name                      string   `hcl:"name,label"`
+	Status                    string   `hcl:"status"`
+	Pending                   []string `hcl:"pending,optional"`
+	Target                    []string `hcl:"target,optional"`
+	Timeout                   string   `hcl:"timeout,optional"`
+	Delay                     string   `hcl:"delay,optional"`
+	MinTimeout                string   `hcl:"min_timeout,optional"`
+	NotFoundChecks            int      `hcl:"not_found_checks,optional"`
+	ContinuousTargetOccurence int      `hcl:"continuous_target_occurence,optional"`
+	LastErrorField            string   `hcl:"last_error_field,optional"`
+	LastErrorStates           []string `hcl:"last_error_states,optional"`
+}
+
+type TemplateData struct {
+	ServicePackage string
+	SourcePackage  string
+	Context        bool
+
+	Statuses []StatusData
+	Waiters  []WaiterData
+}
+
+type StatusData struct {
+	FuncName      string
+	Params        string
+	Finder        string
+	FinderArgs    string
+	StatusField   string
+	NotFoundError bool
+}
+
+type WaiterData struct {
+	FuncName                  string
+	Params                    string
+	OutputType                string
+	StatusFuncName            string
+	StatusArgs                string
+	StatusField               string
+	Pending                   string
+	Target                    string
+	Timeout                   string
+	Delay                     string
+	MinTimeout                string
+	NotFoundChecks            int
+	ContinuousTargetOccurence int
+	LastErrorField            string
+	LastErrorStates           string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	var config Config
+
+	if err := hclsimple.DecodeFile(*declarations, nil, &config); err != nil {
+		log.Fatalf("error decoding %s: %s", *declarations, err)
+	}
+
+	templateData, err := newTemplateData(os.Getenv("GOPACKAGE"), config)
+
+	if err != nil {
+		log.Fatalf("error in %s: %s", *declarations, err)
+	}
+
+	if err := generateTemplateFile(statusFilename, statusTemplateBody, templateData); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generateTemplateFile(waitFilename, waitTemplateBody, templateData); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newTemplateData(servicePackage string, config Config) (*TemplateData, error) {
+	parts := strings.Split(config.ConnType, ".")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("conn_type (%s) must be of the form <package>.<type>", config.ConnType)
+	}
+
+	templateData := &TemplateData{
+		ServicePackage: servicePackage,
+		SourcePackage:  fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", parts[0]),
+		Context:        config.Context,
+	}
+
+	// Parameters common to all generated functions.
+	var params []string
+
+	if config.Context {
+		params = append(params, "ctx context.Context")
+	}
+
+	params = append(params, fmt.Sprintf("conn *%s", config.ConnType))
+
+	statuses := make(map[string]Status)
+
+	for _, status := range config.Statuses {
+		if _, ok := statuses[status.Name]; ok {
+			return nil, fmt.Errorf("duplicate status %q", status.Name)
+		}
+
+		statuses[status.Name] = status
+
+		argNames, err := argNames(status.Args)
+
+		if err != nil {
+			return nil, fmt.Errorf("status %q: %w", status.Name, err)
+		}
+
+		finderArgs := append([]string{"conn"}, argNames...)
+
+		if config.Context {
+			finderArgs = append([]string{"ctx"}, finderArgs...)
+		}
+
+		templateData.Statuses = append(templateData.Statuses, StatusData{
+			FuncName:      funcName("status", status.Name, config.Export),
+			Params:        strings.Join(append(append([]string{}, params...), status.Args...), ", "),
+			Finder:        status.Finder,
+			FinderArgs:    strings.Join(finderArgs, ", "),
+			StatusField:   status.StatusField,
+			NotFoundError: status.NotFoundError,
+		})
+	}
+
+	for _, waiter := range config.Waiters {
+		status, ok := statuses[waiter.Status]
+
+		if !ok {
+			return nil, fmt.Errorf("waiter %q: undeclared status %q", waiter.Name, waiter.Status)
+		}
+
+		if len(waiter.LastErrorStates) > 0 && waiter.LastErrorField == "" {
+			return nil, fmt.Errorf("waiter %q: last_error_states requires last_error_field", waiter.Name)
+		}
+
+		argNames, _ := argNames(status.Args)
+		statusArgs := append([]string{"conn"}, argNames...)
+
+		if config.Context {
+			statusArgs = append([]string{"ctx"}, statusArgs...)
+		}
+
+		waiterParams := append(append([]string{}, params...), status.Args...)
+		timeout := waiter.Timeout
+
+		// Without a declared timeout the caller specifies it, e.g. from d.Timeout().
+		if timeout == "" {
+			waiterParams = append(waiterParams, "timeout time.Duration")
+			timeout = "timeout"
+		}
+
+		templateData.Waiters = append(templateData.Waiters, WaiterData{
+			FuncName:                  funcName("wait", waiter.Name, config.Export),
+			Params:                    strings.Join(waiterParams, ", "),
+			OutputType:                status.OutputType,
+			StatusFuncName:            funcName("status", status.Name, config.Export),
+			StatusArgs:                strings.Join(statusArgs, ", "),
+			StatusField:               status.StatusField,
+			Pending:                   strings.Join(waiter.Pending, ", "),
+			Target:                    strings.Join(waiter.Target, ", "),
+			Timeout:                   timeout,
+			Delay:                     waiter.Delay,
+			MinTimeout:                waiter.MinTimeout,
+			NotFoundChecks:            waiter.NotFoundChecks,
+			ContinuousTargetOccurence: waiter.ContinuousTargetOccurence,
+			LastErrorField:            waiter.LastErrorField,
+			LastErrorStates:           strings.Join(waiter.LastErrorStates, ", "),
+		})
+	}
+
+	return templateData, nil
+}
+
+// argNames returns the parameter names from a list of Go parameter declarations, e.g. "id string".
+func argNames(args []string) ([]string, error) {
+	var names []string
+
+	for _, arg := range args {
+		fields := strings.Fields(arg)
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("argument (%s) must be of the form <name> <type>", arg)
+		}
+
+		names = append(names, fields[0])
+	}
+
+	return names, nil
+}
+
+func funcName(prefix, name string, export bool) string {
+	if export {
+		prefix = strings.Title(prefix)
+	}
+
+	return prefix + name
+}
+
+func generateTemplateFile(filename string, templateBody string, templateData interface{}) error {
+	tmpl, err := template.New(filename).Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	// Also removes any imports unused by the declarations.
+	generatedFileContents, err := imports.Process(filename, buffer.Bytes(), nil)
+
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %w", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+const (
+	statusTemplateBody = `
+// Code generated by internal/generate/waiter/main.go; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+{{- range .Statuses }}
+
+func {{ .FuncName }}({{ .Params }}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := {{ .Finder }}({{ .FinderArgs }})
+{{- if not .NotFoundError }}
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+{{- end }}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{ .StatusField }}), nil
+	}
+}
+{{- end }}
+`
+
+	waitTemplateBody = `
+// Code generated by internal/generate/waiter/main.go; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+{{- $context := .Context }}
+{{- range .Waiters }}
+
+func {{ .FuncName }}({{ .Params }}) (*{{ .OutputType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- .Pending -}} },
+		Target:  []string{ {{- .Target -}} },
+		Refresh: {{ .StatusFuncName }}({{ .StatusArgs }}),
+		Timeout: {{ .Timeout }},
+{{- if .Delay }}
+		Delay: {{ .Delay }},
+{{- end }}
+{{- if .MinTimeout }}
+		MinTimeout: {{ .MinTimeout }},
+{{- end }}
+{{- if .NotFoundChecks }}
+		NotFoundChecks: {{ .NotFoundChecks }},
+{{- end }}
+{{- if .ContinuousTargetOccurence }}
+		ContinuousTargetOccurence: {{ .ContinuousTargetOccurence }},
+{{- end }}
+	}
+
+{{- if $context }}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+{{- else }}
+
+	outputRaw, err := stateConf.WaitForState()
+{{- end }}
+
+	if output, ok := outputRaw.(*{{ .OutputType }}); ok {
+{{- if .LastErrorStates }}
+		switch aws.StringValue(output.{{ .StatusField }}) {
+		case {{ .LastErrorStates }}:
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.{{ .LastErrorField }})))
+		}
+
+{{ else if .LastErrorField }}
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.{{ .LastErrorField }})))
+
+{{ end }}
+		return output, err
+	}
+
+	return nil, err
+}
+{{- end }}
+`
+)
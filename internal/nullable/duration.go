@@ -0,0 +1,104 @@
+package nullable
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableDuration = schema.TypeString
+)
+
+// Duration is a TypeString value holding a Go duration string, e.g. "90s" or "1h30m".
+type Duration string
+
+func (d Duration) IsNull() bool {
+	return d == ""
+}
+
+func (d Duration) Value() (time.Duration, bool, error) {
+	if d.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := time.ParseDuration(string(d))
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+func NewDuration(v time.Duration) Duration {
+	return Duration(v.String())
+}
+
+// DiffSuppressNullableDuration suppresses differences between equivalent durations, e.g. "90s" and "1m30s".
+// Durations read from the API are flattened to their canonical form, so this should be set on every
+// TypeNullableDuration argument to prevent a perpetual difference with the configured value.
+func DiffSuppressNullableDuration(k, o, n string, d *schema.ResourceData) bool {
+	ov, onull, err := Duration(o).Value()
+	if err != nil {
+		return false
+	}
+
+	nv, nnull, err := Duration(n).Value()
+	if err != nil {
+		return false
+	}
+
+	if onull || nnull {
+		return onull == nnull
+	}
+
+	return ov == nv
+}
+
+// ValidateTypeStringNullableDuration provides custom error messaging for TypeString durations
+// Some arguments require a duration value or unspecified, empty field.
+func ValidateTypeStringNullableDuration(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableDurationBetween provides custom error messaging for TypeString durations
+// Some arguments require a duration value or unspecified, empty field.
+func ValidateTypeStringNullableDurationBetween(min time.Duration, max time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be at between (%s) and (%s), got %s", k, min, max, v))
+		}
+
+		return
+	}
+}
@@ -0,0 +1,92 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	runValueTestCases(t, []valueTestCase{
+		{
+			val:           "90s",
+			expectNull:    false,
+			expectedValue: 90 * time.Second,
+		},
+		{
+			val:           "0s",
+			expectNull:    false,
+			expectedValue: time.Duration(0),
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: time.Duration(0),
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: time.Duration(0),
+			expectedErr:   regexp.MustCompile(`invalid duration`),
+		},
+	}, func(val string) (bool, interface{}, bool, error) {
+		v := Duration(val)
+		value, null, err := v.Value()
+		return v.IsNull(), value, null, err
+	})
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1h30m",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+		{
+			val:         90,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "5m",
+			f:   ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+		},
+		{
+			val:         "2h",
+			f:           ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(1m0s\) and \(1h0m0s\), got 2h0m0s`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableDuration(t *testing.T) {
+	testCases := []struct {
+		old, new string
+		expected bool
+	}{
+		{old: "", new: "", expected: true},
+		{old: "1m30s", new: "90s", expected: true},
+		{old: "1h0m0s", new: "60m", expected: true},
+		{old: "0s", new: "0", expected: true},
+		{old: "1m30s", new: "91s"},
+		{old: "", new: "0s"},
+		{old: "0s", new: ""},
+		{old: "1m30s", new: "A"},
+	}
+
+	for _, testCase := range testCases {
+		if got := DiffSuppressNullableDuration("test", testCase.old, testCase.new, nil); got != testCase.expected {
+			t.Errorf("DiffSuppressNullableDuration(%q, %q) = %t, expected %t", testCase.old, testCase.new, got, testCase.expected)
+		}
+	}
+}
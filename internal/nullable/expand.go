@@ -0,0 +1,89 @@
+package nullable
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// The Expand functions convert a nullable Terraform value into the equivalent AWS Go SDK pointer type.
+// A null (or unparsable) value returns nil, which leaves the field unset in the API request.
+// The Flatten functions perform the reverse conversion, with nil flattening to the null value.
+
+func ExpandBool(v interface{}) *bool {
+	if v, null, err := Bool(v.(string)).Value(); !null && err == nil {
+		return aws.Bool(v)
+	}
+
+	return nil
+}
+
+func FlattenBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+
+	return string(NewBool(aws.BoolValue(v)))
+}
+
+func ExpandInt64(v interface{}) *int64 {
+	if v, null, err := Int(v.(string)).Value(); !null && err == nil {
+		return aws.Int64(v)
+	}
+
+	return nil
+}
+
+func FlattenInt64(v *int64) string {
+	if v == nil {
+		return ""
+	}
+
+	return string(NewInt(aws.Int64Value(v)))
+}
+
+func ExpandFloat64(v interface{}) *float64 {
+	if v, null, err := Float(v.(string)).Value(); !null && err == nil {
+		return aws.Float64(v)
+	}
+
+	return nil
+}
+
+func FlattenFloat64(v *float64) string {
+	if v == nil {
+		return ""
+	}
+
+	return string(NewFloat(aws.Float64Value(v)))
+}
+
+func ExpandString(v interface{}) *string {
+	if v, null, _ := String(v.(string)).Value(); !null {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func FlattenString(v *string) string {
+	return aws.StringValue(v)
+}
+
+// ExpandDuration returns the duration as an integer number of the specified unit, e.g. time.Second.
+func ExpandDuration(v interface{}, unit time.Duration) *int64 {
+	if v, null, err := Duration(v.(string)).Value(); !null && err == nil {
+		return aws.Int64(int64(v / unit))
+	}
+
+	return nil
+}
+
+// FlattenDuration returns the duration string for an integer number of the specified unit, e.g. time.Second.
+func FlattenDuration(v *int64, unit time.Duration) string {
+	if v == nil {
+		return ""
+	}
+
+	return string(NewDuration(time.Duration(aws.Int64Value(v)) * unit))
+}
@@ -0,0 +1,51 @@
+package nullable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestExpandFlatten(t *testing.T) {
+	if got := ExpandBool(""); got != nil {
+		t.Errorf("expected ExpandBool to return nil, got %v", aws.BoolValue(got))
+	}
+	if got := ExpandBool("false"); got == nil || aws.BoolValue(got) {
+		t.Errorf("expected ExpandBool to return false, got %v", got)
+	}
+	if got, expected := FlattenBool(aws.Bool(false)), "false"; got != expected {
+		t.Errorf("expected FlattenBool to return %q, got %q", expected, got)
+	}
+
+	if got := ExpandInt64("A"); got != nil {
+		t.Errorf("expected ExpandInt64 to return nil, got %d", aws.Int64Value(got))
+	}
+	if got := ExpandInt64("0"); got == nil || aws.Int64Value(got) != 0 {
+		t.Errorf("expected ExpandInt64 to return 0, got %v", got)
+	}
+	if got, expected := FlattenInt64(nil), ""; got != expected {
+		t.Errorf("expected FlattenInt64 to return %q, got %q", expected, got)
+	}
+
+	if got := ExpandFloat64("0.25"); got == nil || aws.Float64Value(got) != 0.25 {
+		t.Errorf("expected ExpandFloat64 to return 0.25, got %v", got)
+	}
+	if got, expected := FlattenFloat64(aws.Float64(0.25)), "0.25"; got != expected {
+		t.Errorf("expected FlattenFloat64 to return %q, got %q", expected, got)
+	}
+
+	if got := ExpandString(""); got != nil {
+		t.Errorf("expected ExpandString to return nil, got %q", aws.StringValue(got))
+	}
+	if got, expected := FlattenString(aws.String("A")), "A"; got != expected {
+		t.Errorf("expected FlattenString to return %q, got %q", expected, got)
+	}
+
+	if got := ExpandDuration("2m", time.Second); got == nil || aws.Int64Value(got) != 120 {
+		t.Errorf("expected ExpandDuration to return 120, got %v", got)
+	}
+	if got, expected := FlattenDuration(aws.Int64(120), time.Second), "2m0s"; got != expected {
+		t.Errorf("expected FlattenDuration to return %q, got %q", expected, got)
+	}
+}
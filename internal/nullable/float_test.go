@@ -0,0 +1,92 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	runValueTestCases(t, []valueTestCase{
+		{
+			val:           "1.5",
+			expectNull:    false,
+			expectedValue: 1.5,
+		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0.0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0.0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0.0,
+			expectedErr:   regexp.MustCompile(`invalid syntax`),
+		},
+	}, func(val string) (bool, interface{}, bool, error) {
+		v := Float(val)
+		value, null, err := v.Value()
+		return v.IsNull(), value, null, err
+	})
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(1.5),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(2\.0+\), got 1\.50+`),
+		},
+	})
+}
+
+func TestValidationFloatBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0.5",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(0\.0+\) and \(1\.0+\), got 1\.50+`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
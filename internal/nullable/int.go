@@ -29,6 +29,10 @@ func (i Int) Value() (int64, bool, error) {
 	return value, false, nil
 }
 
+func NewInt(v int64) Int {
+	return Int(strconv.FormatInt(v, 10))
+}
+
 // ValidateTypeStringNullableInt provides custom error messaging for TypeString ints
 // Some arguments require an int value or unspecified, empty field.
 func ValidateTypeStringNullableInt(v interface{}, k string) (ws []string, es []error) {
@@ -0,0 +1,80 @@
+package nullable
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableString = schema.TypeString
+)
+
+// String is a TypeString value where the empty string represents not configured.
+// Use it for arguments where the AWS API distinguishes an omitted value from any value that can be configured,
+// so that "" is never sent to the API.
+type String string
+
+func (s String) IsNull() bool {
+	return s == ""
+}
+
+func (s String) Value() (string, bool, error) {
+	if s.IsNull() {
+		return "", true, nil
+	}
+
+	return string(s), false, nil
+}
+
+func NewString(v string) String {
+	return String(v)
+}
+
+// ValidateTypeStringNullableStringLenBetween provides custom error messaging for TypeString strings
+// Some arguments require a string value of a given length or unspecified, empty field.
+func ValidateTypeStringNullableStringLenBetween(min int, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if v := len(value); v < min || v > max {
+			es = append(es, fmt.Errorf("expected length of %s to be in the range (%d - %d), got %d", k, min, max, v))
+		}
+
+		return
+	}
+}
+
+// ValidateTypeStringNullableStringInSlice provides custom error messaging for TypeString strings
+// Some arguments require one of a set of string values or unspecified, empty field.
+func ValidateTypeStringNullableStringInSlice(valid []string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		for _, v := range valid {
+			if v == value {
+				return
+			}
+		}
+
+		es = append(es, fmt.Errorf("expected %s to be one of %q, got %s", k, valid, value))
+
+		return
+	}
+}
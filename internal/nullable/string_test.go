@@ -0,0 +1,66 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNullableString(t *testing.T) {
+	runValueTestCases(t, []valueTestCase{
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: "A",
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: "",
+		},
+	}, func(val string) (bool, interface{}, bool, error) {
+		v := String(val)
+		value, null, err := v.Value()
+		return v.IsNull(), value, null, err
+	})
+}
+
+func TestValidationStringLenBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "abc",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 3),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 3),
+		},
+		{
+			val:         "abcd",
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected length of [\w]+ to be in the range \(1 - 3\), got 4`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationStringInSlice(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "A",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"A", "B"}),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"A", "B"}),
+		},
+		{
+			val:         "C",
+			f:           ValidateTypeStringNullableStringInSlice([]string{"A", "B"}),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \["A" "B"\], got C`),
+		},
+	})
+}
@@ -0,0 +1,80 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testCase struct {
+	val         interface{}
+	f           schema.SchemaValidateFunc
+	expectedErr *regexp.Regexp
+}
+
+func runTestCases(t *testing.T, cases []testCase) {
+	t.Helper()
+
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		// err must match one provided
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range cases {
+		_, errs := tc.f(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
+type valueTestCase struct {
+	val           string
+	expectNull    bool
+	expectedValue interface{}
+	expectedErr   *regexp.Regexp
+}
+
+// runValueTestCases runs IsNull and Value test cases.
+// f returns the results of IsNull and Value for the specified raw value.
+func runValueTestCases(t *testing.T, cases []valueTestCase, f func(string) (bool, interface{}, bool, error)) {
+	t.Helper()
+
+	for i, tc := range cases {
+		isNull, value, null, err := f(tc.val)
+
+		if isNull != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, tc.expectNull, isNull)
+		}
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %v, got %v", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %v", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
@@ -194,7 +195,8 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":                   assumeRoleSchema(),
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -230,6 +232,13 @@ func Provider() *schema.Provider {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Use this to override the default endpoint URL of every service without a service-specific endpoint, " +
+					"for example to use a local stand-in for AWS. Can also be configured using the `AWS_ENDPOINT_URL` environment variable.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -238,6 +247,12 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"allowed_account_ids"},
 				Set:           schema.HashString,
 			},
+			"http_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON-lines record of each AWS API request is appended. " +
+					"Can also be configured using the `TF_AWS_HTTP_LOG_FILE` environment variable.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -258,6 +273,13 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching entire resource tag keys to ignore across all resources.",
+						},
 						"key_prefixes": {
 							Type:        schema.TypeSet,
 							Optional:    true,
@@ -265,6 +287,20 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_suffixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
+						"value_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching entire resource tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -290,12 +326,20 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": rateLimitsSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Specifies how retries are attempted. Valid values are `legacy`, `standard` and `adaptive`. " +
+					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -322,6 +366,7 @@ func Provider() *schema.Provider {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_max_retries": serviceMaxRetriesSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -385,6 +430,7 @@ func Provider() *schema.Provider {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -441,7 +487,8 @@ func Provider() *schema.Provider {
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.DataSourceSchedulingPolicy(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -533,6 +580,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
 			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
@@ -696,6 +744,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_functions":           lambda.DataSourceFunctions(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -772,9 +821,10 @@ func Provider() *schema.Provider {
 			"aws_route53_delegation_set": route53.DataSourceDelegationSet(),
 			"aws_route53_zone":           route53.DataSourceZone(),
 
-			"aws_route53_resolver_endpoint": route53resolver.DataSourceEndpoint(),
-			"aws_route53_resolver_rule":     route53resolver.DataSourceRule(),
-			"aws_route53_resolver_rules":    route53resolver.DataSourceRules(),
+			"aws_route53_resolver_endpoint":  route53resolver.DataSourceEndpoint(),
+			"aws_route53_resolver_endpoints": route53resolver.DataSourceEndpoints(),
+			"aws_route53_resolver_rule":      route53resolver.DataSourceRule(),
+			"aws_route53_resolver_rules":     route53resolver.DataSourceRules(),
 
 			"aws_canonical_user_id": s3.DataSourceCanonicalUserID(),
 			"aws_s3_bucket":         s3.DataSourceBucket(),
@@ -788,6 +838,7 @@ func Provider() *schema.Provider {
 			"aws_secretsmanager_secret":          secretsmanager.DataSourceSecret(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.DataSourceSecretRotation(),
 			"aws_secretsmanager_secret_version":  secretsmanager.DataSourceSecretVersion(),
+			"aws_secretsmanager_secrets":         secretsmanager.DataSourceSecrets(),
 
 			"aws_serverlessapplicationrepository_application": serverlessrepo.DataSourceApplication(),
 
@@ -808,9 +859,11 @@ func Provider() *schema.Provider {
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
 
-			"aws_sns_topic": sns.DataSourceTopic(),
+			"aws_sns_topic":  sns.DataSourceTopic(),
+			"aws_sns_topics": sns.DataSourceTopics(),
 
-			"aws_sqs_queue": sqs.DataSourceQueue(),
+			"aws_sqs_queue":  sqs.DataSourceQueue(),
+			"aws_sqs_queues": sqs.DataSourceQueues(),
 
 			"aws_ssm_document":           ssm.DataSourceDocument(),
 			"aws_ssm_instances":          ssm.DataSourceInstances(),
@@ -1964,6 +2017,9 @@ func Provider() *schema.Provider {
 		return providerConfigure(ctx, d, terraformVersion)
 	}
 
+	addRegionOverrides(provider)
+	addTagPolicyResourceTypes(provider)
+
 	return provider
 }
 
@@ -1974,13 +2030,15 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointURL:                    d.Get("endpoint_url").(string),
 		Endpoints:                      make(map[string]string),
+		HTTPLogFile:                    d.Get("http_log_file").(string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
-		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		RetryMode:                      d.Get("retry_mode").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -2014,9 +2072,31 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		config.SharedCredentialsFiles = l
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		config.AssumeRole = expandAssumeRole(l[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
+	for i, v := range d.Get("assume_role").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if duration, durationSeconds := tfMap["duration"].(string), tfMap["duration_seconds"].(int); duration != "" && durationSeconds != 0 {
+			return nil, diag.Errorf("assume_role.%d: only one of duration or duration_seconds can be set", i)
+		}
+
+		assumeRole := expandAssumeRole(tfMap)
+		config.AssumeRole = append(config.AssumeRole, assumeRole)
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+	}
+
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		assumeRoleWithWebIdentity, err := expandAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.AssumeRoleWithWebIdentity = assumeRoleWithWebIdentity
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -2024,6 +2104,64 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		return nil, diag.FromErr(err)
 	}
 
+	if config.EndpointURL == "" {
+		if v := os.Getenv("AWS_ENDPOINT_URL"); v != "" {
+			if _, errs := validation.IsURLWithHTTPorHTTPS(v, "AWS_ENDPOINT_URL"); len(errs) > 0 {
+				return nil, diag.FromErr(errs[0])
+			}
+
+			config.EndpointURL = v
+		}
+	}
+
+	if config.HTTPLogFile == "" {
+		config.HTTPLogFile = os.Getenv("TF_AWS_HTTP_LOG_FILE")
+	}
+
+	if config.RetryMode == "" {
+		if v := os.Getenv("AWS_RETRY_MODE"); v != "" {
+			if _, errs := validation.StringInSlice(conns.RetryMode_Values(), false)(v, "AWS_RETRY_MODE"); len(errs) > 0 {
+				return nil, diag.FromErr(errs[0])
+			}
+
+			config.RetryMode = v
+		}
+	}
+
+	ignoreTags, err := expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.IgnoreTagsConfig = ignoreTags
+
+	tagPolicy, err := expandProviderTagPolicy(d.Get("tag_policy").([]interface{}))
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.TagPolicyConfig = tagPolicy
+
+	serviceMaxRetries, err := expandServiceMaxRetries(d.Get("service_max_retries").(*schema.Set).List())
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	config.ServiceMaxRetries = serviceMaxRetries
+
+	if v, ok := d.GetOk("rate_limits"); ok {
+		rateLimits, err := expandRateLimits(v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -2041,25 +2179,23 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  ValidAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: ValidAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.0.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use duration in the same assume_role block instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2117,6 +2253,69 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"access_key", "profile", "secret_key", "token"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: ValidAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls. Can also be set with the `AWS_ROLE_ARN` environment variable.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An identifier for the assumed role session. Can also be set with the `AWS_ROLE_SESSION_NAME` environment variable.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ValidateFunc:  validation.StringLenBetween(4, 20000),
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "File containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider. Can also be set with the `AWS_WEB_IDENTITY_TOKEN_FILE` environment variable.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2138,6 +2337,112 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceMaxRetriesSchema() *schema.Schema {
+	serviceMaxRetriesAttributes := make(map[string]*schema.Schema)
+
+	for _, serviceKey := range conns.HCLKeys() {
+		serviceMaxRetriesAttributes[serviceKey] = &schema.Schema{
+			Type:         nullable.TypeNullableInt,
+			Optional:     true,
+			Description:  "Use this to override the maximum number of times an API request to the service is retried",
+			ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: serviceMaxRetriesAttributes,
+		},
+	}
+}
+
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Client-side request rate limits for individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of requests that can be made at once. Defaults to `requests_per_second` rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  "The sustained number of requests per second made to the service.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to rate limit. Valid values are the service keys of the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with rules that resource tags, including default tags, must comply with.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_case": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Case of every resource tag key. Valid values are `camel`, `kebab`, `lower`, `pascal`, `snake` and `upper`.",
+					ValidateFunc: validation.StringInSlice(tftags.KeyCase_Values(), false),
+				},
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      tftags.PolicyModeError,
+					Description:  "Whether violations fail the plan (`error`) or are only logged (`warn`).",
+					ValidateFunc: validation.StringInSlice(tftags.PolicyMode_Values(), false),
+				},
+				"required_tag": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Resource tag that every resource must have.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_value_patterns": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+								Set:         schema.HashString,
+								Description: "Regular expressions, one of which must match the entire tag value if no allowed value is equal to it.",
+							},
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Set:         schema.HashString,
+								Description: "Values that the tag may have.",
+							},
+							"key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Tag key.",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	assumeRole := awsbase.AssumeRole{}
 
@@ -2221,9 +2526,9 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
-func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
+func expandProviderIgnoreTags(l []interface{}) (*tftags.IgnoreConfig, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -2233,11 +2538,177 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.Keys = tftags.New(v.List())
 	}
 
+	if v, ok := m["key_patterns"].(*schema.Set); ok {
+		keyPatterns, err := expandRegexps(v.List())
+
+		if err != nil {
+			return nil, fmt.Errorf("ignore_tags key_patterns: %w", err)
+		}
+
+		ignoreConfig.KeyPatterns = keyPatterns
+	}
+
 	if v, ok := m["key_prefixes"].(*schema.Set); ok {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := m["key_suffixes"].(*schema.Set); ok {
+		ignoreConfig.KeySuffixes = tftags.New(v.List())
+	}
+
+	if v, ok := m["value_patterns"].(*schema.Set); ok {
+		valuePatterns, err := expandRegexps(v.List())
+
+		if err != nil {
+			return nil, fmt.Errorf("ignore_tags value_patterns: %w", err)
+		}
+
+		ignoreConfig.ValuePatterns = valuePatterns
+	}
+
+	return ignoreConfig, nil
+}
+
+// expandRegexps compiles the regular expressions, anchoring each so that it must match an entire string.
+func expandRegexps(tfList []interface{}) ([]*regexp.Regexp, error) {
+	var regexps []*regexp.Regexp
+
+	for _, v := range tfList {
+		re, err := regexp.Compile(`^(?:` + v.(string) + `)$`)
+
+		if err != nil {
+			return nil, err
+		}
+
+		regexps = append(regexps, re)
+	}
+
+	return regexps, nil
+}
+
+func expandProviderTagPolicy(l []interface{}) (*tftags.PolicyConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["key_case"].(string); ok {
+		policyConfig.KeyCase = v
+	}
+
+	if v, ok := m["mode"].(string); ok {
+		policyConfig.Mode = v
+	}
+
+	if v, ok := m["required_tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requiredTag := &tftags.RequiredTag{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				for _, v := range v.List() {
+					requiredTag.AllowedValues = append(requiredTag.AllowedValues, v.(string))
+				}
+			}
+
+			if v, ok := tfMap["allowed_value_patterns"].(*schema.Set); ok {
+				allowedValuePatterns, err := expandRegexps(v.List())
+
+				if err != nil {
+					return nil, fmt.Errorf("tag_policy required tag (%s) allowed_value_patterns: %w", requiredTag.Key, err)
+				}
+
+				requiredTag.AllowedValuePatterns = allowedValuePatterns
+			}
+
+			policyConfig.RequiredTags = append(policyConfig.RequiredTags, requiredTag)
+		}
+	}
+
+	return policyConfig, nil
+}
+
+func expandRateLimits(tfList []interface{}) (map[string]conns.RateLimit, error) {
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		hclKey := tfMap["service"].(string)
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit (%s): %w", hclKey, err)
+		}
+
+		if _, ok := rateLimits[serviceKey]; ok {
+			return nil, fmt.Errorf("duplicate rate limit for service (%s)", hclKey)
+		}
+
+		rateLimits[serviceKey] = conns.RateLimit{
+			Burst:             tfMap["burst"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return rateLimits, nil
+}
+
+func expandServiceMaxRetries(tfList []interface{}) (map[string]int, error) {
+	serviceMaxRetries := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, hclKey := range conns.HCLKeys() {
+			v, ok := tfMap[hclKey].(string)
+
+			if !ok {
+				continue
+			}
+
+			maxRetries, null, err := nullable.Int(v).Value()
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign maximum number of retries (%s): %w", hclKey, err)
+			}
+
+			if null {
+				continue
+			}
+
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign maximum number of retries (%s): %w", hclKey, err)
+			}
+
+			if _, ok := serviceMaxRetries[serviceKey]; ok {
+				return nil, fmt.Errorf("duplicate maximum number of retries for service (%s)", hclKey)
+			}
+
+			serviceMaxRetries[serviceKey] = int(maxRetries)
+		}
+	}
+
+	return serviceMaxRetries, nil
 }
 
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
@@ -2279,3 +2750,60 @@ func expandEndpoints(endpointsSetList []interface{}, out map[string]string) erro
 
 	return nil
 }
+
+func expandAssumeRoleWithWebIdentity(m map[string]interface{}) (*conns.AssumeRoleWithWebIdentity, error) {
+	assumeRole := conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		assumeRole.Duration = duration
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	} else if v := os.Getenv("AWS_ROLE_ARN"); v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	} else if v := os.Getenv("AWS_ROLE_SESSION_NAME"); v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	} else if v := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); v != "" && assumeRole.WebIdentityToken == "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	if assumeRole.RoleARN == "" {
+		return nil, fmt.Errorf("assume_role_with_web_identity: role_arn must be set or the AWS_ROLE_ARN environment variable must be set")
+	}
+
+	if assumeRole.WebIdentityToken == "" && assumeRole.WebIdentityTokenFile == "" {
+		return nil, fmt.Errorf("assume_role_with_web_identity: one of web_identity_token or web_identity_token_file must be set or the AWS_WEB_IDENTITY_TOKEN_FILE environment variable must be set")
+	}
+
+	return &assumeRole, nil
+}
@@ -2,10 +2,16 @@ package provider
 
 import (
 	"os"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestExpandEndpoints(t *testing.T) {
@@ -170,6 +176,104 @@ func TestEndpointEnvVarPrecedence(t *testing.T) {
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	testcases := []struct {
+		name        string
+		rateLimits  []interface{}
+		expected    map[string]conns.RateLimit
+		expectError bool
+	}{
+		{
+			name:     "empty",
+			expected: map[string]conns.RateLimit{},
+		},
+		{
+			name: "alias keys",
+			rateLimits: []interface{}{
+				map[string]interface{}{"service": "ec2", "requests_per_second": 20.0, "burst": 0},
+				map[string]interface{}{"service": "applicationautoscaling", "requests_per_second": 2.5, "burst": 5},
+			},
+			expected: map[string]conns.RateLimit{
+				conns.EC2:            {RequestsPerSecond: 20},
+				conns.AppAutoScaling: {RequestsPerSecond: 2.5, Burst: 5},
+			},
+		},
+		{
+			name: "duplicate service",
+			rateLimits: []interface{}{
+				map[string]interface{}{"service": "appautoscaling", "requests_per_second": 1.0, "burst": 0},
+				map[string]interface{}{"service": "applicationautoscaling", "requests_per_second": 2.0, "burst": 0},
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown service",
+			rateLimits: []interface{}{
+				map[string]interface{}{"service": "nope", "requests_per_second": 1.0, "burst": 0},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			got, err := expandRateLimits(testcase.rateLimits)
+
+			if testcase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testcase.expected) {
+				t.Errorf("got %v, expected %v", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestExpandServiceMaxRetries(t *testing.T) {
+	serviceMaxRetries := make(map[string]interface{})
+	for _, serviceKey := range conns.HCLKeys() {
+		serviceMaxRetries[serviceKey] = ""
+	}
+	serviceMaxRetries["route53"] = "50"
+	serviceMaxRetries["s3"] = "0"
+
+	got, err := expandServiceMaxRetries([]interface{}{serviceMaxRetries})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]int{
+		conns.Route53: 50,
+		conns.S3:      0,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestExpandServiceMaxRetriesDuplicateService(t *testing.T) {
+	serviceMaxRetries := make(map[string]interface{})
+	for _, serviceKey := range conns.HCLKeys() {
+		serviceMaxRetries[serviceKey] = ""
+	}
+	serviceMaxRetries["appautoscaling"] = "5"
+	serviceMaxRetries["applicationautoscaling"] = "10"
+
+	if _, err := expandServiceMaxRetries([]interface{}{serviceMaxRetries}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -188,3 +292,348 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAssumeRoleWithWebIdentity(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Env         map[string]string
+		Input       map[string]interface{}
+		Expected    *conns.AssumeRoleWithWebIdentity
+		ExpectError bool
+	}{
+		{
+			Name: "configuration",
+			Input: map[string]interface{}{
+				"duration":           "1h",
+				"role_arn":           "arn:aws:iam::123456789012:role/test",
+				"session_name":       "test",
+				"web_identity_token": "token",
+			},
+			Expected: &conns.AssumeRoleWithWebIdentity{
+				Duration:         time.Hour,
+				RoleARN:          "arn:aws:iam::123456789012:role/test",
+				SessionName:      "test",
+				WebIdentityToken: "token",
+			},
+		},
+		{
+			Name: "environment variables",
+			Env: map[string]string{
+				"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/env",
+				"AWS_ROLE_SESSION_NAME":       "env",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "/var/run/token",
+			},
+			Input: map[string]interface{}{},
+			Expected: &conns.AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::123456789012:role/env",
+				SessionName:          "env",
+				WebIdentityTokenFile: "/var/run/token",
+			},
+		},
+		{
+			Name: "configuration overrides environment variables",
+			Env: map[string]string{
+				"AWS_ROLE_ARN":                "arn:aws:iam::123456789012:role/env",
+				"AWS_WEB_IDENTITY_TOKEN_FILE": "/var/run/token",
+			},
+			Input: map[string]interface{}{
+				"role_arn":           "arn:aws:iam::123456789012:role/test",
+				"web_identity_token": "token",
+			},
+			Expected: &conns.AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::123456789012:role/test",
+				WebIdentityToken: "token",
+			},
+		},
+		{
+			Name: "no role ARN",
+			Input: map[string]interface{}{
+				"web_identity_token": "token",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "no token",
+			Input: map[string]interface{}{
+				"role_arn": "arn:aws:iam::123456789012:role/test",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testCase.Env {
+				os.Setenv(k, v)
+			}
+
+			got, err := expandAssumeRoleWithWebIdentity(testCase.Input)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestProviderAssumeRoleWithWebIdentityConflicts(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		expectError bool
+	}{
+		{
+			name: "web identity",
+		},
+		{
+			name:        "access_key",
+			config:      map[string]interface{}{"access_key": "AKIAEXAMPLE", "secret_key": "secret"},
+			expectError: true,
+		},
+		{
+			name:        "profile",
+			config:      map[string]interface{}{"profile": "example"},
+			expectError: true,
+		},
+		{
+			name:        "token",
+			config:      map[string]interface{}{"token": "token"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"assume_role_with_web_identity": []interface{}{
+					map[string]interface{}{
+						"role_arn":           "arn:aws:iam::123456789012:role/example",
+						"web_identity_token": "token",
+					},
+				},
+			}
+
+			for k, v := range testCase.config {
+				raw[k] = v
+			}
+
+			diags := Provider().Validate(terraform.NewResourceConfigRaw(raw))
+
+			if got, expected := diags.HasError(), testCase.expectError; got != expected {
+				t.Errorf("got error %t, expected %t: %v", got, expected, diags)
+			}
+		})
+	}
+}
+
+func TestExpandProviderTagPolicy(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Input       []interface{}
+		Expected    *tftags.PolicyConfig
+		ExpectError bool
+	}{
+		{
+			Name:     "no configuration",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "configuration",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_case": tftags.KeyCasePascal,
+					"mode":     tftags.PolicyModeWarn,
+					"required_tag": []interface{}{
+						map[string]interface{}{
+							"allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{}),
+							"allowed_values":         schema.NewSet(schema.HashString, []interface{}{}),
+							"key":                    "Owner",
+						},
+						map[string]interface{}{
+							"allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{`cc-\d+`}),
+							"allowed_values":         schema.NewSet(schema.HashString, []interface{}{"shared"}),
+							"key":                    "CostCenter",
+						},
+					},
+				},
+			},
+			Expected: &tftags.PolicyConfig{
+				KeyCase: tftags.KeyCasePascal,
+				Mode:    tftags.PolicyModeWarn,
+				RequiredTags: []*tftags.RequiredTag{
+					{
+						Key: "Owner",
+					},
+					{
+						AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^(?:cc-\d+)$`)},
+						AllowedValues:        []string{"shared"},
+						Key:                  "CostCenter",
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid pattern",
+			Input: []interface{}{
+				map[string]interface{}{
+					"required_tag": []interface{}{
+						map[string]interface{}{
+							"allowed_value_patterns": schema.NewSet(schema.HashString, []interface{}{`(`}),
+							"key":                    "CostCenter",
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandProviderTagPolicy(testCase.Input)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandProviderIgnoreTags(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Input       []interface{}
+		Expected    *tftags.IgnoreConfig
+		ExpectError bool
+	}{
+		{
+			Name:     "no configuration",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "configuration",
+			Input: []interface{}{
+				map[string]interface{}{
+					"keys":           schema.NewSet(schema.HashString, []interface{}{"key1"}),
+					"key_patterns":   schema.NewSet(schema.HashString, []interface{}{`kubernetes\.io/cluster/.*`}),
+					"key_prefixes":   schema.NewSet(schema.HashString, []interface{}{"aws:cloudformation:"}),
+					"key_suffixes":   schema.NewSet(schema.HashString, []interface{}{"-managed-by"}),
+					"value_patterns": schema.NewSet(schema.HashString, []interface{}{`managed-by-.*`}),
+				},
+			},
+			Expected: &tftags.IgnoreConfig{
+				Keys:          tftags.New([]interface{}{"key1"}),
+				KeyPatterns:   []*regexp.Regexp{regexp.MustCompile(`^(?:kubernetes\.io/cluster/.*)$`)},
+				KeyPrefixes:   tftags.New([]interface{}{"aws:cloudformation:"}),
+				KeySuffixes:   tftags.New([]interface{}{"-managed-by"}),
+				ValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^(?:managed-by-.*)$`)},
+			},
+		},
+		{
+			Name: "invalid pattern",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_patterns": schema.NewSet(schema.HashString, []interface{}{`(`}),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandProviderIgnoreTags(testCase.Input)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandRegexps(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Pattern  string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "entire string",
+			Pattern:  `kubernetes\.io/cluster/.*`,
+			Input:    "kubernetes.io/cluster/test",
+			Expected: true,
+		},
+		{
+			Name:    "substring",
+			Pattern: `kubernetes\.io/cluster/`,
+			Input:   "kubernetes.io/cluster/test",
+		},
+		{
+			Name:    "alternation",
+			Pattern: `a|b`,
+			Input:   "ab",
+		},
+		{
+			Name:     "already anchored",
+			Pattern:  `^cc-\d+$`,
+			Input:    "cc-1234",
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			regexps, err := expandRegexps([]interface{}{testCase.Pattern})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := regexps[0].MatchString(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	regionAttributeName = "region"
+	regionImportIDSep   = "@"
+)
+
+// globalTypeNamePrefixes are the type name prefixes of resources and data sources that
+// belong to global services or that make no regional AWS API calls.
+// TestGlobalServiceTypeNames checks this list against the AWS SDK endpoint metadata.
+var globalTypeNamePrefixes = []string{
+	"aws_account_",
+	"aws_arn",
+	"aws_billing_service_account",
+	"aws_budgets_",
+	"aws_canonical_user_id",
+	"aws_chime_",
+	"aws_cloudfront_",
+	"aws_default_tags",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_ip_ranges",
+	"aws_networkmanager_",
+	"aws_organizations_",
+	"aws_partition",
+	"aws_pricing_",
+	"aws_route53_",
+	"aws_route53domains_",
+	"aws_route53recoverycontrolconfig_",
+	"aws_route53recoveryreadiness_",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// regionalTypeNamePrefixes are exceptions to globalTypeNamePrefixes.
+var regionalTypeNamePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// isRegionalTypeName returns whether the resource or data source type name belongs to a regional service.
+func isRegionalTypeName(typeName string) bool {
+	if hasTypeNamePrefix(typeName, regionalTypeNamePrefixes) {
+		return true
+	}
+
+	return !hasTypeNamePrefix(typeName, globalTypeNamePrefixes)
+}
+
+// hasTypeNamePrefix returns whether the type name begins with any of the prefixes.
+func hasTypeNamePrefix(typeName string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addRegionOverrides adds an optional `region` argument to every regional resource and data source
+// that does not already have a top-level `region` attribute.
+func addRegionOverrides(provider *schema.Provider) {
+	for typeName, r := range provider.ResourcesMap {
+		if isRegionalTypeName(typeName) {
+			addRegionOverride(r, true)
+		}
+	}
+
+	for typeName, r := range provider.DataSourcesMap {
+		if isRegionalTypeName(typeName) {
+			addRegionOverride(r, false)
+		}
+	}
+}
+
+// addRegionOverride adds an optional `region` argument to the resource or data source and wraps its
+// CRUD handlers so that they are called with an AWSClient for the configured region.
+// The region is recorded in state so that subsequent operations use the same region.
+func addRegionOverride(r *schema.Resource, isResource bool) {
+	if _, ok := r.Schema[regionAttributeName]; ok {
+		return
+	}
+
+	r.Schema[regionAttributeName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     isResource,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The region in which to manage the resource. Defaults to the region configured in the provider.",
+	}
+
+	// Record the region after a successful create or read.
+	setRegion := true
+	// Updates and deletes use the region recorded in state.
+	noSetRegion := false
+
+	if f := r.Create; f != nil {
+		r.Create = regionalFunc(f, setRegion)
+	}
+	if f := r.Read; f != nil {
+		r.Read = regionalFunc(f, setRegion)
+	}
+	if f := r.Update; f != nil {
+		r.Update = regionalFunc(f, noSetRegion)
+	}
+	if f := r.Delete; f != nil {
+		r.Delete = regionalFunc(f, noSetRegion)
+	}
+	if f := r.Exists; f != nil { //nolint:staticcheck // Wrapping existing implementations.
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) { //nolint:staticcheck // Wrapping existing implementations.
+			meta, _, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return false, err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = regionalContextFunc(f, setRegion)
+	}
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = regionalContextFunc(f, setRegion)
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = regionalContextFunc(f, noSetRegion)
+	}
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = regionalContextFunc(f, noSetRegion)
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = regionalContextFunc(f, setRegion)
+	}
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = regionalContextFunc(f, setRegion)
+	}
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = regionalContextFunc(f, noSetRegion)
+	}
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = regionalContextFunc(f, noSetRegion)
+	}
+
+	if importer := r.Importer; importer != nil {
+		if f := importer.State; f != nil { //nolint:staticcheck // Wrapping existing implementations.
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:staticcheck // Wrapping existing implementations.
+				meta, err := regionalImportMeta(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(d, meta)
+			}
+		}
+
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				meta, err := regionalImportMeta(d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return f(ctx, d, meta)
+			}
+		}
+	}
+}
+
+func regionalFunc(f func(*schema.ResourceData, interface{}) error, setRegion bool) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		meta, region, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		if setRegion && d.Id() != "" {
+			return d.Set(regionAttributeName, region)
+		}
+
+		return nil
+	}
+}
+
+func regionalContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, setRegion bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, region, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, meta)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if setRegion && d.Id() != "" {
+			if err := d.Set(regionAttributeName, region); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// regionalMeta returns the AWSClient for the region configured in (or recorded in the state of) the resource.
+func regionalMeta(d *schema.ResourceData, meta interface{}) (interface{}, string, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return meta, "", nil
+	}
+
+	region := client.Region
+
+	if v, ok := d.Get(regionAttributeName).(string); ok && v != "" {
+		region = v
+	}
+
+	regionalClient, err := client.RegionalClient(region)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return regionalClient, region, nil
+}
+
+// regionalImportMeta handles import IDs of the form <ID>@<region>, recording the region and
+// returning the AWSClient for that region.
+func regionalImportMeta(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	if id, region, ok := parseRegionalImportID(d.Id()); ok {
+		d.SetId(id)
+
+		if err := d.Set(regionAttributeName, region); err != nil {
+			return nil, fmt.Errorf("setting %s: %w", regionAttributeName, err)
+		}
+	}
+
+	meta, _, err := regionalMeta(d, meta)
+
+	return meta, err
+}
+
+// parseRegionalImportID splits an import ID of the form <ID>@<region>.
+// The suffix is only treated as a region if it is a known region name,
+// so IDs that legitimately contain the separator (e.g. email addresses) are unaffected.
+func parseRegionalImportID(importID string) (string, string, bool) {
+	i := strings.LastIndex(importID, regionImportIDSep)
+
+	if i <= 0 {
+		return importID, "", false
+	}
+
+	id, region := importID[:i], importID[i+len(regionImportIDSep):]
+
+	for _, p := range endpoints.DefaultPartitions() {
+		if _, ok := p.Regions()[region]; ok {
+			return id, region, true
+		}
+	}
+
+	return importID, "", false
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestIsRegionalTypeName(t *testing.T) {
+	testCases := map[string]bool{
+		"aws_instance":                  true,
+		"aws_route53_resolver_endpoint": true,
+		"aws_s3_bucket":                 true,
+		"aws_iam_role":                  false,
+		"aws_route53_zone":              false,
+		"aws_cloudfront_distribution":   false,
+		"aws_partition":                 false,
+	}
+
+	for typeName, expected := range testCases {
+		if got := isRegionalTypeName(typeName); got != expected {
+			t.Errorf("isRegionalTypeName(%q) = %t, expected %t", typeName, got, expected)
+		}
+	}
+}
+
+// TestGlobalServiceTypeNames checks that the resources and data sources of services that the AWS SDK endpoint metadata
+// describes as global, i.e. that have the same endpoint in every region, don't get a region override.
+// Add the type name prefix of any new global service to globalTypeNamePrefixes.
+func TestGlobalServiceTypeNames(t *testing.T) {
+	provider := Provider()
+	typeNames := make([]string, 0, len(provider.ResourcesMap)+len(provider.DataSourcesMap))
+
+	for typeName := range provider.ResourcesMap {
+		typeNames = append(typeNames, typeName)
+	}
+
+	for typeName := range provider.DataSourcesMap {
+		typeNames = append(typeNames, typeName)
+	}
+
+	for _, hclKey := range conns.HCLKeys() {
+		serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !isGlobalEndpointsID(conns.ServiceEndpointsID(serviceKey)) {
+			continue
+		}
+
+		prefix := "aws_" + hclKey
+
+		for _, typeName := range typeNames {
+			if typeName != prefix && !strings.HasPrefix(typeName, prefix+"_") {
+				continue
+			}
+
+			if hasTypeNamePrefix(typeName, regionalTypeNamePrefixes) {
+				continue
+			}
+
+			if isRegionalTypeName(typeName) {
+				t.Errorf("%s belongs to global service %s but is regional, add its prefix to globalTypeNamePrefixes", typeName, serviceKey)
+			}
+		}
+	}
+}
+
+// isGlobalEndpointsID returns whether the service has the same endpoint in different regions of the AWS partition.
+func isGlobalEndpointsID(id string) bool {
+	if id == "" {
+		return false
+	}
+
+	usWest2, err := endpoints.AwsPartition().EndpointFor(id, endpoints.UsWest2RegionID)
+
+	if err != nil {
+		return false
+	}
+
+	euWest1, err := endpoints.AwsPartition().EndpointFor(id, endpoints.EuWest1RegionID)
+
+	if err != nil {
+		return false
+	}
+
+	return usWest2.URL == euWest1.URL
+}
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedOK     bool
+	}{
+		{
+			ImportID:   "i-1234567890abcdef0",
+			ExpectedID: "i-1234567890abcdef0",
+		},
+		{
+			ImportID:       "i-1234567890abcdef0@eu-west-1",
+			ExpectedID:     "i-1234567890abcdef0",
+			ExpectedRegion: "eu-west-1",
+			ExpectedOK:     true,
+		},
+		{
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			ImportID:   "@eu-west-1",
+			ExpectedID: "@eu-west-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		id, region, ok := parseRegionalImportID(testCase.ImportID)
+
+		if id != testCase.ExpectedID || region != testCase.ExpectedRegion || ok != testCase.ExpectedOK {
+			t.Errorf("parseRegionalImportID(%q) = (%q, %q, %t), expected (%q, %q, %t)", testCase.ImportID, id, region, ok, testCase.ExpectedID, testCase.ExpectedRegion, testCase.ExpectedOK)
+		}
+	}
+}
+
+func TestAddRegionOverrides(t *testing.T) {
+	provider := Provider()
+
+	for typeName, r := range provider.ResourcesMap {
+		if !isRegionalTypeName(typeName) {
+			continue
+		}
+
+		v, ok := r.Schema[regionAttributeName]
+
+		if !ok {
+			t.Errorf("resource %s has no %s attribute", typeName, regionAttributeName)
+			continue
+		}
+
+		if v.Type != schema.TypeString {
+			t.Errorf("resource %s %s attribute is not a string", typeName, regionAttributeName)
+		}
+	}
+
+	for _, typeName := range []string{"aws_iam_role", "aws_route53_zone"} {
+		if _, ok := provider.ResourcesMap[typeName].Schema[regionAttributeName]; ok {
+			t.Errorf("global resource %s has a %s attribute", typeName, regionAttributeName)
+		}
+	}
+
+	if err := provider.InternalValidate(); err != nil {
+		t.Fatalf("provider schema is invalid: %s", err)
+	}
+}
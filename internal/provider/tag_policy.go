@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// addTagPolicyResourceTypes wraps the CustomizeDiff function of each resource with tags so that
+// it is called with the resource type in its context, for tag policy violations to name the resource.
+func addTagPolicyResourceTypes(provider *schema.Provider) {
+	for typeName, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; !ok || r.CustomizeDiff == nil {
+			continue
+		}
+
+		typeName, customizeDiff := typeName, r.CustomizeDiff
+
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(verify.WithResourceType(ctx, typeName), diff, meta)
+		}
+	}
+}
@@ -40,7 +40,7 @@ func sweepAnalyzers(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(analyzer.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_accessanalyzer_analyzer", r, d, client))
 		}
 
 		return !lastPage
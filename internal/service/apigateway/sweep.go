@@ -90,7 +90,7 @@ func sweepVPCLinks(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_api_gateway_vpc_link", r, d, client))
 		}
 		return !lastPage
 	})
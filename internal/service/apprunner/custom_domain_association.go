@@ -102,7 +102,7 @@ func resourceCustomDomainAssociationCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("error associating App Runner Custom Domain (%s) for Service (%s): empty output", domainName, serviceArn))
 	}
 
-	d.SetId(fmt.Sprintf("%s,%s", aws.StringValue(output.CustomDomain.DomainName), aws.StringValue(output.ServiceArn)))
+	d.SetId(CustomDomainAssociationCreateID(aws.StringValue(output.CustomDomain.DomainName), aws.StringValue(output.ServiceArn)))
 	d.Set("dns_target", output.DNSTarget)
 
 	if err := WaitCustomDomainAssociationCreated(ctx, conn, domainName, serviceArn); err != nil {
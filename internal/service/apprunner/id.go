@@ -1,14 +1,21 @@
 package apprunner
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
+var customDomainAssociationIDFormat = tfid.NewFormat(",", "domain_name", "service_arn")
+
+func CustomDomainAssociationCreateID(domainName, serviceArn string) string {
+	return customDomainAssociationIDFormat.ID(domainName, serviceArn)
+}
+
 func CustomDomainAssociationParseID(id string) (string, string, error) {
-	idParts := strings.Split(id, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format of ID (%s), expected domain_name,service_arn", id)
+	parts, err := customDomainAssociationIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
 	}
-	return idParts[0], idParts[1], nil
+
+	return parts[0], parts[1], nil
 }
@@ -55,7 +55,7 @@ func sweepDirectoryConfigs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.DirectoryName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_directory_config", r, d, client))
 		}
 
 		return !lastPage
@@ -98,7 +98,7 @@ func sweepFleets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_fleet", r, d, client))
 		}
 
 		return !lastPage
@@ -141,7 +141,7 @@ func sweepImageBuilders(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_image_builder", r, d, client))
 		}
 
 		return !lastPage
@@ -184,7 +184,7 @@ func sweepStacks(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_stack", r, d, client))
 		}
 
 		return !lastPage
@@ -68,7 +68,7 @@ func sweepGraphQLAPIs(region string) error {
 			id := aws.StringValue(graphAPI.ApiId)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appsync_graphql_api", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -123,7 +123,7 @@ func sweepDomainNames(region string) error {
 			id := aws.StringValue(dm.DomainName)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appsync_domain_name", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -178,7 +178,7 @@ func sweepDomainNameAssociations(region string) error {
 			id := aws.StringValue(dm.DomainName)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appsync_domain_name_api_association", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
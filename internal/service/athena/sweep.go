@@ -53,7 +53,7 @@ func sweepDatabases(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_athena_database", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
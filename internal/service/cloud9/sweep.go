@@ -40,7 +40,7 @@ func sweepEnvironmentEC2s(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloud9_environment_ec2", r, d, client))
 		}
 
 		return !lastPage
@@ -0,0 +1,25 @@
+conn_type = "cloud9.Cloud9"
+
+status "EnvironmentStatus" {
+  finder       = "FindEnvironmentByID"
+  args         = ["id string"]
+  output_type  = "cloud9.Environment"
+  status_field = "Lifecycle.Status"
+}
+
+waiter "EnvironmentReady" {
+  status            = "EnvironmentStatus"
+  pending           = ["cloud9.EnvironmentLifecycleStatusCreating"]
+  target            = ["cloud9.EnvironmentLifecycleStatusCreated"]
+  timeout           = "EnvironmentReadyTimeout"
+  last_error_field  = "Lifecycle.Reason"
+  last_error_states = ["cloud9.EnvironmentLifecycleStatusCreateFailed"]
+}
+
+waiter "EnvironmentDeleted" {
+  status            = "EnvironmentStatus"
+  pending           = ["cloud9.EnvironmentLifecycleStatusDeleting"]
+  timeout           = "EnvironmentDeletedTimeout"
+  last_error_field  = "Lifecycle.Reason"
+  last_error_states = ["cloud9.EnvironmentLifecycleStatusDeleteFailed"]
+}
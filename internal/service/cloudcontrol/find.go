@@ -76,3 +76,34 @@ func FindResourceByID(ctx context.Context, conn *cloudcontrolapi.CloudControlApi
 
 	return output.ResourceDescription, nil
 }
+
+// FindResources returns the resources of a type, retrying any page request that is throttled.
+func FindResources(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, input *cloudcontrolapi.ListResourcesInput) ([]*cloudcontrolapi.ResourceDescription, error) {
+	var output []*cloudcontrolapi.ResourceDescription
+
+	for {
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, listResourcesThrottlingTimeout, func() (interface{}, error) {
+			return conn.ListResourcesWithContext(ctx, input)
+		}, cloudcontrolapi.ErrCodeThrottlingException)
+
+		if err != nil {
+			return nil, err
+		}
+
+		page := outputRaw.(*cloudcontrolapi.ListResourcesOutput)
+
+		for _, v := range page.ResourceDescriptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
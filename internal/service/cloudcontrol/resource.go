@@ -19,7 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/mattbaird/jsonpatch"
 )
 
 func ResourceResource() *schema.Resource {
@@ -37,8 +36,9 @@ func ResourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: desiredStateDiffSuppress,
 			},
 			"properties": {
 				Type:     schema.TypeString,
@@ -152,7 +152,19 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		var cfResource *cfschema.Resource
+
+		if v := d.Get("schema").(string); v != "" {
+			_, resource, err := newResourceSchema(v)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			cfResource = resource
+		}
+
+		patchDocument, err := patchDocument(cfResource, oldRaw.(string), newRaw.(string))
 
 		if err != nil {
 			return diag.Diagnostics{
@@ -276,18 +288,13 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	newSchema, err := cfschema.Sanitize(newSchema)
+	cfResourceSchema, cfResource, err := newResourceSchema(newSchema)
 
 	if err != nil {
-		return fmt.Errorf("error sanitizing CloudFormation Resource Schema JSON: %w", err)
-	}
-
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
-
-	if err != nil {
-		return fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
+	// Validates property names, types, required properties, enums and patterns.
 	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
 		return fmt.Errorf("error validating desired_state against CloudFormation Resource Schema: %w", err)
 	}
@@ -297,20 +304,14 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
-
-	if err != nil {
-		return fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
-	}
-
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	patches, err := desiredStatePatches(cfResource, oldDesiredStateRaw.(string), newDesiredState)
 
 	if err != nil {
 		return fmt.Errorf("error creating desired_state JSON Patch: %w", err)
 	}
 
 	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+		if isCreateOnlyPropertyPath(cfResource, patch.Path) {
 			if err := diff.ForceNew("desired_state"); err != nil {
 				return fmt.Errorf("error setting desired_state ForceNew: %w", err)
 			}
@@ -323,8 +324,9 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 }
 
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+// Read-only properties are ignored if the resource type schema is known.
+func patchDocument(cfResource *cfschema.Resource, old, new string) (string, error) {
+	patch, err := desiredStatePatches(cfResource, old, new)
 
 	if err != nil {
 		return "", err
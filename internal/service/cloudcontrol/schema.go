@@ -0,0 +1,141 @@
+package cloudcontrol
+
+import (
+	"fmt"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// newResourceSchema parses a CloudFormation resource type schema document.
+func newResourceSchema(document string) (*cfschema.ResourceJsonSchema, *cfschema.Resource, error) {
+	document, err := cfschema.Sanitize(document)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resourceJsonSchema, err := cfschema.NewResourceJsonSchemaDocument(document)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := resourceJsonSchema.Resource()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return resourceJsonSchema, resource, nil
+}
+
+// desiredStatePatches returns the JSON Patch operations describing the difference between `old` and `new`,
+// excluding any operations on read-only properties, whose values are only ever set by the API.
+// Write-only properties are kept: desired_state is never refreshed, so a change to one is always a configuration change.
+func desiredStatePatches(resource *cfschema.Resource, old, new string) ([]jsonpatch.JsonPatchOperation, error) {
+	patches, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]jsonpatch.JsonPatchOperation, 0, len(patches))
+
+	for _, patch := range patches {
+		if resource != nil && propertyPathWithin(patch.Path, resource.ReadOnlyProperties) {
+			continue
+		}
+
+		result = append(result, patch)
+	}
+
+	return result, nil
+}
+
+// desiredStateDiffSuppress suppresses desired_state differences that are only
+// in formatting or to read-only properties.
+func desiredStateDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	var resource *cfschema.Resource
+
+	if v := d.Get("schema").(string); v != "" {
+		var err error
+
+		if _, resource, err = newResourceSchema(v); err != nil {
+			return false
+		}
+	}
+
+	patches, err := desiredStatePatches(resource, old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return len(patches) == 0
+}
+
+// isCreateOnlyPropertyPath returns whether a change at the JSON Patch path affects a create-only property,
+// either within the property or by replacing or removing one of its ancestors.
+func isCreateOnlyPropertyPath(resource *cfschema.Resource, path string) bool {
+	if resource == nil {
+		return false
+	}
+
+	pathParts := jsonPointerParts(path)
+
+	for _, ptr := range resource.CreateOnlyProperties {
+		ptrParts := ptr.Path()
+
+		if pathPartsHavePrefix(pathParts, ptrParts) || pathPartsHavePrefix(ptrParts, pathParts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// propertyPathWithin returns whether the JSON Patch path is within one of the properties.
+func propertyPathWithin(path string, ptrs cfschema.PropertyJsonPointers) bool {
+	pathParts := jsonPointerParts(path)
+
+	for _, ptr := range ptrs {
+		if pathPartsHavePrefix(pathParts, ptr.Path()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pathPartsHavePrefix returns whether the path starts with the prefix.
+// A "*" part matches any array index.
+func pathPartsHavePrefix(path, prefix []string) bool {
+	if len(prefix) == 0 || len(path) < len(prefix) {
+		return false
+	}
+
+	for i, part := range prefix {
+		if part != "*" && path[i] != "*" && part != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func jsonPointerParts(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
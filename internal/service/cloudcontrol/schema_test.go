@@ -0,0 +1,234 @@
+package cloudcontrol
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testResourceSchema = `{
+  "typeName": "Test::Service::Resource",
+  "description": "Test resource",
+  "additionalProperties": false,
+  "properties": {
+    "Arn": {"type": "string"},
+    "Name": {"type": "string", "pattern": "^[a-z]+$"},
+    "Password": {"type": "string"},
+    "Settings": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "Engine": {"type": "string", "enum": ["a", "b"]},
+        "Size": {"type": "integer"}
+      }
+    },
+    "Tags": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "Key": {"type": "string"},
+          "Value": {"type": "string"}
+        }
+      }
+    }
+  },
+  "required": ["Name"],
+  "createOnlyProperties": ["/properties/Name", "/properties/Settings/Engine", "/properties/Tags/*/Key"],
+  "readOnlyProperties": ["/properties/Arn"],
+  "writeOnlyProperties": ["/properties/Password"],
+  "primaryIdentifier": ["/properties/Name"]
+}`
+
+func TestValidateDesiredState(t *testing.T) {
+	resourceJsonSchema, _, err := newResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		TestName      string
+		DesiredState  string
+		ExpectedError bool
+	}{
+		{
+			TestName:     "valid",
+			DesiredState: `{"Name": "test", "Settings": {"Engine": "a", "Size": 1}}`,
+		},
+		{
+			TestName:      "missing required property",
+			DesiredState:  `{"Settings": {"Engine": "a"}}`,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "unknown property",
+			DesiredState:  `{"Name": "test", "Nmae": "test"}`,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "unknown nested property",
+			DesiredState:  `{"Name": "test", "Settings": {"Sise": 1}}`,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid type",
+			DesiredState:  `{"Name": "test", "Settings": {"Size": "1"}}`,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid enum value",
+			DesiredState:  `{"Name": "test", "Settings": {"Engine": "c"}}`,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid pattern",
+			DesiredState:  `{"Name": "Test"}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := resourceJsonSchema.ValidateConfigurationDocument(testCase.DesiredState)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestIsCreateOnlyPropertyPath(t *testing.T) {
+	_, resource, err := newResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Path     string
+		Expected bool
+	}{
+		{Path: "/Name", Expected: true},
+		{Path: "/Settings", Expected: true},
+		{Path: "/Settings/Engine", Expected: true},
+		{Path: "/Settings/Size", Expected: false},
+		{Path: "/Tags/0/Key", Expected: true},
+		{Path: "/Tags/1", Expected: true},
+		{Path: "/Tags/0/Value", Expected: false},
+		{Path: "/Password", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			if got := isCreateOnlyPropertyPath(resource, testCase.Path); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStateDiffSuppress(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Schema   string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			TestName: "formatting",
+			Old:      `{"Name": "test", "Settings": {"Size": 1}}`,
+			New:      `{"Settings":{"Size":1},"Name":"test"}`,
+			Expected: true,
+		},
+		{
+			TestName: "changed",
+			Old:      `{"Name": "test", "Settings": {"Size": 1}}`,
+			New:      `{"Name": "test", "Settings": {"Size": 2}}`,
+		},
+		{
+			TestName: "read-only",
+			Schema:   testResourceSchema,
+			Old:      `{"Name": "test"}`,
+			New:      `{"Name": "test", "Arn": "arn:aws:test:::test"}`,
+			Expected: true,
+		},
+		{
+			TestName: "write-only",
+			Schema:   testResourceSchema,
+			Old:      `{"Name": "test", "Password": "old"}`,
+			New:      `{"Name": "test", "Password": "new"}`,
+		},
+		{
+			TestName: "write-only without schema",
+			Old:      `{"Name": "test", "Password": "old"}`,
+			New:      `{"Name": "test", "Password": "new"}`,
+		},
+		{
+			TestName: "write-only and changed",
+			Schema:   testResourceSchema,
+			Old:      `{"Name": "test", "Password": "old"}`,
+			New:      `{"Name": "test", "Password": "new", "Settings": {"Size": 2}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceResource().Schema, map[string]interface{}{
+				"schema": testCase.Schema,
+			})
+
+			if got := desiredStateDiffSuppress("desired_state", testCase.Old, testCase.New, d); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPatchDocument(t *testing.T) {
+	_, resource, err := newResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		TestName string
+		Old      string
+		New      string
+		Expected string
+	}{
+		{
+			TestName: "read-only",
+			Old:      `{"Name": "test"}`,
+			New:      `{"Name": "test", "Arn": "arn:aws:test:::test"}`,
+			Expected: `[]`,
+		},
+		{
+			TestName: "write-only",
+			Old:      `{"Name": "test", "Password": "old"}`,
+			New:      `{"Name": "test", "Password": "new"}`,
+			Expected: `[{"op":"replace","path":"/Password","value":"new"}]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := patchDocument(resource, testCase.Old, testCase.New)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -75,7 +75,7 @@ func sweepStackSetInstances(region string) error {
 					)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudformation_stack_set_instance", r, d, client))
 				}
 
 				return !lastPage
@@ -132,7 +132,7 @@ func sweepStackSets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(summary.StackSetName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudformation_stack_set", r, d, client))
 		}
 
 		return !lastPage
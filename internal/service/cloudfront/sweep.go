@@ -115,7 +115,7 @@ func sweepCachePolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_cache_policy", r, d, client))
 		}
 
 		return !lastPage
@@ -172,7 +172,7 @@ func sweepDistributions(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_distribution", r, d, client))
 		}
 
 		return !lastPage
@@ -432,7 +432,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_field_level_encryption_config", r, d, client))
 		}
 
 		return !lastPage
@@ -489,7 +489,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_field_level_encryption_profile", r, d, client))
 		}
 
 		return !lastPage
@@ -548,7 +548,7 @@ func sweepOriginRequestPolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_origin_request_policy", r, d, client))
 		}
 
 		return !lastPage
@@ -607,7 +607,7 @@ func sweepResponseHeadersPolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudfront_response_headers_policy", r, d, client))
 		}
 
 		return !lastPage
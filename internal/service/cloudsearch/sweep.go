@@ -41,7 +41,7 @@ func sweepDomains(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(domain.DomainName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudsearch_domain", r, d, client))
 	}
 
 	if sweep.SkipSweepError(err) {
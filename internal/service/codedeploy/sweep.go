@@ -47,7 +47,7 @@ func sweepApps(region string) error {
 			d.SetId(fmt.Sprintf("%s:%s", "xxxx", appName))
 			d.Set("name", appName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_codedeploy_app", r, d, client))
 		}
 
 		return !lastPage
@@ -46,7 +46,7 @@ func sweepPipelines(region string) error {
 
 			d.SetId(aws.StringValue(pipeline.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_codepipeline", r, d, client))
 		}
 
 		return !lastPage
@@ -54,7 +54,7 @@ func sweepInstance(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_connect_instance", r, d, client))
 		}
 
 		return !lastPage
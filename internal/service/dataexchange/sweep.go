@@ -46,7 +46,7 @@ func sweepDataSets(region string) error {
 
 			d.SetId(aws.StringValue(dataSet.Id))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dataexchange_data_set", r, d, client))
 		}
 
 		return !lastPage
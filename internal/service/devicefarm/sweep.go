@@ -59,7 +59,7 @@ func sweepProjects(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_devicefarm_project", r, d, client))
 		}
 
 		return !lastPage
@@ -113,7 +113,7 @@ func sweepTestGridProjects(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_devicefarm_test_grid_project", r, d, client))
 		}
 
 		return !lastPage
@@ -48,7 +48,7 @@ func sweepReplicationInstances(region string) error {
 			d.Set("replication_instance_arn", instance.ReplicationInstanceArn)
 			d.SetId(aws.StringValue(instance.ReplicationInstanceIdentifier))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dms_replication_instance", r, d, client))
 		}
 
 		return !lastPage
@@ -91,7 +91,7 @@ func sweepReplicationTasks(region string) error {
 			d.SetId(aws.StringValue(instance.ReplicationTaskIdentifier))
 			d.Set("replication_task_arn", instance.ReplicationTaskArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dms_replication_task", r, d, client))
 		}
 
 		return !lastPage
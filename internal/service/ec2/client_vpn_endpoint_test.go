@@ -12,17 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const clientVpnEndpointDefaultLimit = 5
 
-var testAccEc2ClientVpnEndpointSemaphore sync.Semaphore
+var testAccEc2ClientVpnEndpointSemaphore acctest.Semaphore
 
 func init() {
-	testAccEc2ClientVpnEndpointSemaphore = sync.InitializeSemaphore("AWS_EC2_CLIENT_VPN_LIMIT", clientVpnEndpointDefaultLimit)
+	testAccEc2ClientVpnEndpointSemaphore = acctest.NewSemaphore("AWS_EC2_CLIENT_VPN_LIMIT", clientVpnEndpointDefaultLimit)
 }
 
 // This is part of an experimental feature, do not use this as a starting point for tests
@@ -703,7 +702,7 @@ func testAccClientVPNEndpoint_vpcSecurityGroups(t *testing.T) {
 }
 
 func testAccPreCheckClientVPNSyncronize(t *testing.T) {
-	sync.TestAccPreCheckSyncronize(t, testAccEc2ClientVpnEndpointSemaphore, "Client VPN")
+	acctest.PreCheckSemaphore(t, testAccEc2ClientVpnEndpointSemaphore, "Client VPN")
 }
 
 func testAccCheckClientVPNEndpointDestroy(s *terraform.State) error {
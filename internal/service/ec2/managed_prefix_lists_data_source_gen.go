@@ -0,0 +1,89 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=ManagedPrefixLists -DataSourceDescription=EC2 Managed Prefix List -DataSourceListOp=DescribeManagedPrefixLists -DataSourceItems=PrefixLists -DataSourceIDField=PrefixListId -DataSourceARNField=PrefixListArn -DataSourceNameField=PrefixListName -DataSourceFilters=Ec2Filters -DataSourceTagFilters"; DO NOT EDIT.
+
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceManagedPrefixLists() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedPrefixListsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": namevaluesfilters.Schema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceManagedPrefixListsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeManagedPrefixListsInput{}
+
+	filters := namevaluesfilters.New(d.Get("filter").(*schema.Set))
+	filters.Add(namevaluesfilters.Tags(tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()))
+
+	if len(filters) > 0 {
+		input.Filters = filters.Ec2Filters()
+	}
+
+	var arns, ids, names []string
+
+	err := conn.DescribeManagedPrefixListsPages(input, func(page *ec2.DescribeManagedPrefixListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PrefixLists {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.PrefixListId)
+			name := aws.StringValue(v.PrefixListName)
+			arn := aws.StringValue(v.PrefixListArn)
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Managed Prefix Lists: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2ManagedPrefixListsDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2ManagedPrefixListsDataSource_filter(t *testing.T) {
+	prefixListName := fmt.Sprintf("com.amazonaws.%s.s3", acctest.Region())
+	dataSourceName := "data.aws_ec2_managed_prefix_lists.test"
+	prefixListDataSourceName := "data.aws_ec2_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckEc2ManagedPrefixList(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedPrefixListsDataSourceConfig_filter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", prefixListDataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", prefixListDataSourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", prefixListName),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagedPrefixListsDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_managed_prefix_lists" "test" {
+  tags = {
+    Name = aws_ec2_managed_prefix_list.test.tags["Name"]
+  }
+}
+`, rName)
+}
+
+const testAccManagedPrefixListsDataSourceConfig_filter = `
+data "aws_region" "current" {}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  name = "com.amazonaws.${data.aws_region.current.name}.s3"
+}
+
+data "aws_ec2_managed_prefix_lists" "test" {
+  filter {
+    name   = "prefix-list-name"
+    values = [data.aws_ec2_managed_prefix_list.test.name]
+  }
+}
+`
@@ -466,7 +466,7 @@ func sweepClientVPNEndpoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.ClientVpnEndpointId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_client_vpn_endpoint", r, d, client))
 		}
 
 		return !lastPage
@@ -521,7 +521,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 					d.SetId(aws.StringValue(v.AssociationId))
 					d.Set("client_vpn_endpoint_id", v.ClientVpnEndpointId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_client_vpn_network_association", r, d, client))
 				}
 
 				return !lastPage
@@ -621,7 +621,7 @@ func sweepEBSSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.SnapshotId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ebs_snapshot", r, d, client))
 		}
 
 		return !lastPage
@@ -664,7 +664,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.EgressOnlyInternetGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_egress_only_internet_gateway", r, d, client))
 		}
 
 		return !lastPage
@@ -735,7 +735,7 @@ func sweepEIPs(region string) error {
 			d.SetId(aws.StringValue(address.PublicIp))
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eip", r, d, client))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -769,7 +769,7 @@ func sweepFlowLogs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(flowLog.FlowLogId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_flow_log", r, d, client))
 		}
 
 		return !lastPage
@@ -812,7 +812,7 @@ func sweepHosts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(host.HostId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_host", r, d, client))
 		}
 
 		return !lastPage
@@ -870,7 +870,7 @@ func sweepInstances(region string) error {
 				d.SetId(id)
 				d.Set("disable_api_termination", false)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_instance", r, d, client))
 			}
 		}
 		return !lastPage
@@ -950,7 +950,7 @@ func sweepInternetGateways(region string) error {
 				d.Set("vpc_id", internetGateway.Attachments[0].VpcId)
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_internet_gateway", r, d, client))
 		}
 
 		return !lastPage
@@ -1072,7 +1072,7 @@ func sweepNATGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.NatGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_nat_gateway", r, d, client))
 		}
 
 		return !lastPage
@@ -1127,7 +1127,7 @@ func sweepNetworkACLs(region string) error {
 
 			d.Set("vpc_id", v.VpcId)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_network_acl", r, d, client))
 		}
 
 		return !lastPage
@@ -1217,7 +1217,7 @@ func sweepNetworkInsightsPaths(region string) error {
 			d := r.Data(nil)
 
 			d.SetId(id)
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_network_insights_path", r, d, client))
 		}
 
 		return !lastPage
@@ -1260,7 +1260,7 @@ func sweepPlacementGroups(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(placementGroup.GroupName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_placement_group", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -1511,7 +1511,7 @@ func sweepSpotFleetRequests(region string) error {
 			d.SetId(id)
 			d.Set("terminate_instances_with_expiration", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_spot_fleet_request", r, d, client))
 		}
 
 		return !lastPage
@@ -1557,7 +1557,7 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.SubnetId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_subnet", r, d, client))
 		}
 
 		return !lastPage
@@ -1662,7 +1662,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.TransitGatewayMulticastDomainId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_transit_gateway_multicast_domain", r, d, client))
 		}
 
 		return !lastPage
@@ -1709,7 +1709,7 @@ func sweepTransitGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.TransitGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_transit_gateway", r, d, client))
 		}
 
 		return !lastPage
@@ -1756,7 +1756,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.TransitGatewayConnectPeerId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_transit_gateway_connect_peer", r, d, client))
 		}
 
 		return !lastPage
@@ -1803,7 +1803,7 @@ func sweepTransitGatewayConnects(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.TransitGatewayAttachmentId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_transit_gateway_connect", r, d, client))
 		}
 
 		return !lastPage
@@ -1850,7 +1850,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.TransitGatewayAttachmentId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_transit_gateway_vpc_attachment", r, d, client))
 		}
 
 		return !lastPage
@@ -1920,7 +1920,7 @@ func sweepVPCDHCPOptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.DhcpOptionsId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_dhcp_options", r, d, client))
 		}
 
 		return !lastPage
@@ -2083,7 +2083,7 @@ func sweepVPCPeeringConnections(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VpcPeeringConnectionId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_peering_connection", r, d, client))
 		}
 
 		return !lastPage
@@ -2115,6 +2115,7 @@ func sweepVPCs(region string) error {
 	conn := client.(*conns.AWSClient).EC2Conn
 	input := &ec2.DescribeVpcsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
+	vpcs := make(map[string]*sweep.SweepResource)
 
 	err = conn.DescribeVpcsPages(input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
 		if page == nil {
@@ -2131,7 +2132,9 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VpcId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			vpc := sweep.NewSweepResource("aws_vpc", r, d, client)
+			vpcs[aws.StringValue(v.VpcId)] = vpc
+			sweepResources = append(sweepResources, vpc)
 		}
 
 		return !lastPage
@@ -2146,6 +2149,35 @@ func sweepVPCs(region string) error {
 		return fmt.Errorf("error listing EC2 VPCs (%s): %w", region, err)
 	}
 
+	// Delete any subnets left by the aws_subnet sweeper before their VPC.
+	err = conn.DescribeSubnetsPages(&ec2.DescribeSubnetsInput{}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Subnets {
+			vpc, ok := vpcs[aws.StringValue(v.VpcId)]
+
+			if !ok {
+				continue
+			}
+
+			r := ResourceSubnet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SubnetId))
+
+			subnet := sweep.NewSweepResource("aws_subnet", r, d, client)
+			vpc.DependsOn(subnet)
+			sweepResources = append(sweepResources, subnet)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Subnets (%s): %w", region, err)
+	}
+
 	err = sweep.SweepOrchestrator(sweepResources)
 
 	if err != nil {
@@ -2184,7 +2216,7 @@ func sweepVPNConnections(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.VpnConnectionId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpn_connection", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2233,7 +2265,7 @@ func sweepVPNGateways(region string) error {
 			}
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpn_gateway", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2274,7 +2306,7 @@ func sweepCustomerGateways(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.CustomerGatewayId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_customer_gateway", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -2317,7 +2349,7 @@ func sweepIPAMPoolCIDRs(region string) error {
 					d := r.Data(nil)
 					d.SetId(encodeIpamPoolCidrId(aws.StringValue(v.Cidr), poolID))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_ipam_pool_cidr", r, d, client))
 				}
 
 				return !lastPage
@@ -2372,7 +2404,7 @@ func sweepIPAMPools(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.IpamPoolId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_ipam_pool", r, d, client))
 		}
 
 		return !lastPage
@@ -2422,7 +2454,7 @@ func sweepIPAMScopes(region string) error {
 			d := r.Data(nil)
 			d.SetId(scopeID)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_ipam_scope", r, d, client))
 		}
 
 		return !lastPage
@@ -2465,7 +2497,7 @@ func sweepIPAMs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.IpamId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_vpc_ipam", r, d, client))
 		}
 
 		return !lastPage
@@ -2516,7 +2548,7 @@ func sweepAMIs(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(v.ImageId))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ami", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
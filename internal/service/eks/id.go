@@ -1,82 +1,69 @@
 package eks
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
-const addonResourceIDSeparator = ":"
+var addonResourceIDFormat = tfid.NewFormat(":", "cluster-name", "addon-name")
 
 func AddonCreateResourceID(clusterName, addonName string) string {
-	parts := []string{clusterName, addonName}
-	id := strings.Join(parts, addonResourceIDSeparator)
-
-	return id
+	return addonResourceIDFormat.ID(clusterName, addonName)
 }
 
 func AddonParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, addonResourceIDSeparator)
+	parts, err := addonResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]saddon-name", id, addonResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
-const fargateProfileResourceIDSeparator = ":"
+var fargateProfileResourceIDFormat = tfid.NewFormat(":", "cluster-name", "fargate-profile-name")
 
 func FargateProfileCreateResourceID(clusterName, fargateProfileName string) string {
-	parts := []string{clusterName, fargateProfileName}
-	id := strings.Join(parts, fargateProfileResourceIDSeparator)
-
-	return id
+	return fargateProfileResourceIDFormat.ID(clusterName, fargateProfileName)
 }
 
 func FargateProfileParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, fargateProfileResourceIDSeparator)
+	parts, err := fargateProfileResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sfargate-profile-name", id, fargateProfileResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
-const identityProviderConfigResourceIDSeparator = ":"
+var identityProviderConfigResourceIDFormat = tfid.NewFormat(":", "cluster-name", "config-name")
 
 func IdentityProviderConfigCreateResourceID(clusterName, configName string) string {
-	parts := []string{clusterName, configName}
-	id := strings.Join(parts, identityProviderConfigResourceIDSeparator)
-
-	return id
+	return identityProviderConfigResourceIDFormat.ID(clusterName, configName)
 }
 
 func IdentityProviderConfigParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, identityProviderConfigResourceIDSeparator)
+	parts, err := identityProviderConfigResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]sconfig-name", id, identityProviderConfigResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
-const nodeGroupResourceIDSeparator = ":"
+var nodeGroupResourceIDFormat = tfid.NewFormat(":", "cluster-name", "node-group-name")
 
 func NodeGroupCreateResourceID(clusterName, nodeGroupName string) string {
-	parts := []string{clusterName, nodeGroupName}
-	id := strings.Join(parts, nodeGroupResourceIDSeparator)
-
-	return id
+	return nodeGroupResourceIDFormat.ID(clusterName, nodeGroupName)
 }
 
 func NodeGroupParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, nodeGroupResourceIDSeparator)
+	parts, err := nodeGroupResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]snode-group-name", id, nodeGroupResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
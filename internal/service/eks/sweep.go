@@ -79,7 +79,7 @@ func sweepAddon(region string) error {
 					d := r.Data(nil)
 					d.SetId(AddonCreateResourceID(aws.StringValue(cluster), aws.StringValue(addon)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eks_addon", r, d, client))
 				}
 
 				return !lastPage
@@ -134,7 +134,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(cluster))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eks_cluster", r, d, client))
 		}
 
 		return !lastPage
@@ -188,7 +188,7 @@ func sweepFargateProfiles(region string) error {
 					d := r.Data(nil)
 					d.SetId(FargateProfileCreateResourceID(aws.StringValue(cluster), aws.StringValue(profile)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eks_fargate_profile", r, d, client))
 				}
 
 				return !lastPage
@@ -255,7 +255,7 @@ func sweepIdentityProvidersConfig(region string) error {
 					d := r.Data(nil)
 					d.SetId(IdentityProviderConfigCreateResourceID(aws.StringValue(cluster), aws.StringValue(identityProviderConfig.Name)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eks_identity_provider_config", r, d, client))
 				}
 
 				return !lastPage
@@ -321,7 +321,7 @@ func sweepNodeGroups(region string) error {
 					d := r.Data(nil)
 					d.SetId(NodeGroupCreateResourceID(aws.StringValue(cluster), aws.StringValue(nodeGroup)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eks_node_group", r, d, client))
 				}
 
 				return !lastPage
@@ -245,7 +245,7 @@ func sweepReplicationGroups(region string) error {
 
 			d.SetId(aws.StringValue(replicationGroup.ReplicationGroupId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_elasticache_replication_group", r, d, client))
 		}
 
 		return !lastPage
@@ -84,7 +84,7 @@ func sweepDomains(region string) error {
 		d.SetId(name)
 		d.Set("domain_name", name)
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_elasticsearch_domain", r, d, client))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -1,23 +1,29 @@
 package elbv2
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
-const listenerCertificateIDSeparator = "_"
+// listenerCertificateIDFormat is the format of listener certificate IDs.
+// The certificate ARN may contain the separator.
+var listenerCertificateIDFormat = tfid.Format{
+	Parts: []tfid.Part{
+		{Name: "listener-arn"},
+		{Name: "certificate-arn", Greedy: true},
+	},
+	Separator: "_",
+}
 
 func listenerCertificateParseID(id string) (string, string, error) {
-	parts := strings.SplitN(id, listenerCertificateIDSeparator, 2)
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	parts, err := listenerCertificateIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "",
-		fmt.Errorf("unexpected format for ID (%q), expected listener-arn"+listenerCertificateIDSeparator+
-			"certificate-arn", id)
+	return parts[0], parts[1], nil
 }
 
 func listenerCertificateCreateID(listenerArn, certificateArn string) string {
-	return strings.Join([]string{listenerArn, listenerCertificateIDSeparator, certificateArn}, "")
+	return listenerCertificateIDFormat.ID(listenerArn, certificateArn)
 }
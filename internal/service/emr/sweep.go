@@ -103,7 +103,7 @@ func sweepStudios(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(studio.StudioId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_emr_studio", r, d, client))
 		}
 
 		return !lastPage
@@ -1,120 +1,93 @@
 package events
 
 import (
-	"fmt"
 	"regexp"
-	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
 var (
 	eventBusARNPattern     = regexp.MustCompile(`^arn:aws[\w-]*:events:[a-z]{2}-[a-z]+-[\w-]+:[0-9]{12}:event-bus\/[\.\-_A-Za-z0-9]+$`)
 	partnerEventBusPattern = regexp.MustCompile(`^aws\.partner(/[\.\-_A-Za-z0-9]+){2,}$`)
+
+	// eventBusNamePattern matches event bus names in rule and target IDs, which may contain the separator only if they are ARNs or partner event bus names.
+	eventBusNamePattern = regexp.MustCompile(`^[^/]+$|` + eventBusARNPattern.String() + `|` + partnerEventBusPattern.String())
 )
 
-const permissionResourceIDSeparator = "/"
+var permissionResourceIDFormat = tfid.Format{
+	Parts: []tfid.Part{
+		{Name: "EVENTBUSNAME", Optional: true, Default: DefaultEventBusName},
+		{Name: "STATEMENTID"},
+	},
+	Separator: "/",
+}
 
 func PermissionCreateResourceID(eventBusName, statementID string) string {
-	if eventBusName == "" || eventBusName == DefaultEventBusName {
-		return statementID
-	}
-
-	parts := []string{eventBusName, statementID}
-	id := strings.Join(parts, permissionResourceIDSeparator)
-
-	return id
+	return permissionResourceIDFormat.ID(eventBusName, statementID)
 }
 
 func PermissionParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, permissionResourceIDSeparator)
+	parts, err := permissionResourceIDFormat.Parse(id)
 
-	if len(parts) == 1 && parts[0] != "" {
-		return DefaultEventBusName, parts[0], nil
-	}
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sSTATEMENTID or STATEMENTID", id, permissionResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
-const ruleResourceIDSeparator = "/"
+var ruleResourceIDFormat = tfid.Format{
+	Parts: []tfid.Part{
+		{Name: "EVENTBUSNAME", Optional: true, Default: DefaultEventBusName, Greedy: true, Pattern: eventBusNamePattern},
+		{Name: "RULENAME"},
+	},
+	Separator: "/",
+}
 
 func RuleCreateResourceID(eventBusName, ruleName string) string {
-	if eventBusName == "" || eventBusName == DefaultEventBusName {
-		return ruleName
-	}
-
-	parts := []string{eventBusName, ruleName}
-	id := strings.Join(parts, ruleResourceIDSeparator)
-
-	return id
+	return ruleResourceIDFormat.ID(eventBusName, ruleName)
 }
 
 func RuleParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, ruleResourceIDSeparator)
+	parts, err := ruleResourceIDFormat.Parse(id)
 
-	if len(parts) == 1 && parts[0] != "" {
-		return DefaultEventBusName, parts[0], nil
-	}
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
-	}
-	if len(parts) > 2 {
-		i := strings.LastIndex(id, ruleResourceIDSeparator)
-		eventBusName := id[:i]
-		ruleName := id[i+1:]
-		if eventBusARNPattern.MatchString(eventBusName) && ruleName != "" {
-			return eventBusName, ruleName, nil
-		}
-		if partnerEventBusPattern.MatchString(eventBusName) && ruleName != "" {
-			return eventBusName, ruleName, nil
-		}
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME or RULENAME", id, ruleResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
 // Terraform resource IDs for Targets are not parseable as the separator used ("-") is also a valid character in both the rule name and the target ID.
 
-const targetResourceIDSeparator = "-"
-const targetImportIDSeparator = "/"
-
-func TargetCreateResourceID(eventBusName, ruleName, targetID string) string {
-	var parts []string
-
-	if eventBusName == "" || eventBusName == DefaultEventBusName {
-		parts = []string{ruleName, targetID}
-	} else {
-		parts = []string{eventBusName, ruleName, targetID}
-	}
+var targetResourceIDFormat = tfid.Format{
+	Parts: []tfid.Part{
+		{Name: "EVENTBUSNAME", Optional: true, Default: DefaultEventBusName},
+		{Name: "RULENAME"},
+		{Name: "TARGETID"},
+	},
+	Separator: "-",
+}
 
-	id := strings.Join(parts, targetResourceIDSeparator)
+var targetImportIDFormat = tfid.Format{
+	Parts: []tfid.Part{
+		{Name: "EVENTBUSNAME", Optional: true, Default: DefaultEventBusName, Greedy: true, Pattern: eventBusNamePattern},
+		{Name: "RULENAME"},
+		{Name: "TARGETID"},
+	},
+	Separator: "/",
+}
 
-	return id
+func TargetCreateResourceID(eventBusName, ruleName, targetID string) string {
+	return targetResourceIDFormat.ID(eventBusName, ruleName, targetID)
 }
 
 func TargetParseImportID(id string) (string, string, string, error) {
-	parts := strings.Split(id, targetImportIDSeparator)
+	parts, err := targetImportIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return DefaultEventBusName, parts[0], parts[1], nil
-	}
-	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
-		return parts[0], parts[1], parts[2], nil
-	}
-	if len(parts) > 3 {
-		iTarget := strings.LastIndex(id, targetImportIDSeparator)
-		targetID := id[iTarget+1:]
-		iRule := strings.LastIndex(id[:iTarget], targetImportIDSeparator)
-		eventBusName := id[:iRule]
-		ruleName := id[iRule+1 : iTarget]
-		if eventBusARNPattern.MatchString(eventBusName) && ruleName != "" && targetID != "" {
-			return eventBusName, ruleName, targetID, nil
-		}
-		if partnerEventBusPattern.MatchString(eventBusName) && ruleName != "" && targetID != "" {
-			return eventBusName, ruleName, targetID, nil
-		}
+	if err != nil {
+		return "", "", "", err
 	}
 
-	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME%[2]sTARGETID or RULENAME%[2]sTARGETID", id, targetImportIDSeparator)
+	return parts[0], parts[1], parts[2], nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
 )
 
 func resourceTargetV0() *schema.Resource {
@@ -170,13 +171,7 @@ func resourceTargetV0() *schema.Resource {
 }
 
 func TargetStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	if rawState == nil {
-		rawState = map[string]interface{}{}
-	}
-
-	if _, ok := rawState["event_bus_name"]; !ok {
-		rawState["event_bus_name"] = DefaultEventBusName
-	}
-
-	return rawState, nil
+	return stateupgrade.Apply(rawState,
+		stateupgrade.Default("event_bus_name", DefaultEventBusName),
+	)
 }
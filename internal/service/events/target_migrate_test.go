@@ -2,10 +2,12 @@ package events_test
 
 import (
 	"context"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
 )
 
 func testResourceTargetStateDataV0() map[string]interface{} {
@@ -68,3 +70,7 @@ func TestTargetStateUpgradeV0EventBusName(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
 	}
 }
+
+func TestTargetStateUpgradeGolden(t *testing.T) {
+	stateupgrade.CheckGolden(t, tfevents.ResourceTarget(), filepath.Join("testdata", "state", "target"))
+}
@@ -48,7 +48,7 @@ func sweepDeliveryStreams(region string) error {
 			d.SetId("???")
 			d.Set("name", sn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_kinesis_firehose_delivery_stream", r, d, client))
 		}
 
 		if !aws.BoolValue(page.HasMoreDeliveryStreams) {
@@ -81,7 +81,7 @@ func sweepFSXBackups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.BackupId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_backup", r, d, client))
 		}
 
 		return !lastPage
@@ -129,7 +129,7 @@ func sweepFSXLustreFileSystems(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.FileSystemId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_lustre_file_system", r, d, client))
 		}
 
 		return !lastPage
@@ -177,7 +177,7 @@ func sweepFSXOntapFileSystems(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.FileSystemId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_ontap_file_system", r, d, client))
 		}
 
 		return !lastPage
@@ -221,7 +221,7 @@ func sweepFSXOntapStorageVirtualMachine(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vm.StorageVirtualMachineId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_ontap_storage_virtual_machine", r, d, client))
 		}
 
 		return !lastPage
@@ -272,7 +272,7 @@ func sweepFSXOntapVolume(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VolumeId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_ontap_volume", r, d, client))
 		}
 
 		return !lastPage
@@ -320,7 +320,7 @@ func sweepFSXOpenzfsFileSystems(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.FileSystemId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_openzfs_file_system", r, d, client))
 		}
 
 		return !lastPage
@@ -371,7 +371,7 @@ func sweepFSXOpenzfsVolume(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VolumeId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_openzfs_volume", r, d, client))
 		}
 
 		return !lastPage
@@ -420,7 +420,7 @@ func sweepFSXWindowsFileSystems(region string) error {
 			d.SetId(aws.StringValue(fs.FileSystemId))
 			d.Set("skip_final_backup", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_windows_file_system", r, d, client))
 		}
 
 		return !lastPage
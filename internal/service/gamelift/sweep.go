@@ -193,7 +193,7 @@ func sweepFleets(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_gamelift_fleet", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -247,7 +247,7 @@ func sweepGameServerGroups(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_gamelift_game_server_group", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -2,21 +2,19 @@ package iam
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfarn"
 )
 
 const (
-	ARNSeparator = "/"
-	ARNService   = "iam"
+	ARNService = "iam"
 
 	InstanceProfileResourcePrefix = "instance-profile"
 )
 
 // InstanceProfileARNToName converts Amazon Resource Name (ARN) to Name.
 func InstanceProfileARNToName(inputARN string) (string, error) {
-	parsedARN, err := arn.Parse(inputARN)
+	parsedARN, err := tfarn.Parse(inputARN)
 
 	if err != nil {
 		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
@@ -26,15 +24,9 @@ func InstanceProfileARNToName(inputARN string) (string, error) {
 		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
 	}
 
-	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
-
-	if actual, expected := len(resourceParts), 2; actual < expected {
-		return "", fmt.Errorf("expected at least %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
-	}
-
-	if actual, expected := resourceParts[0], InstanceProfileResourcePrefix; actual != expected {
+	if actual, expected := parsedARN.ResourceType, InstanceProfileResourcePrefix; actual != expected {
 		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
 	}
 
-	return resourceParts[len(resourceParts)-1], nil
+	return parsedARN.ResourceID, nil
 }
@@ -32,7 +32,7 @@ func TestInstanceProfileARNToName(t *testing.T) {
 		{
 			TestName:      "invalid ARN resource parts",
 			InputARN:      "arn:aws:iam:us-east-1:123456789012:name", //lintignore:AWSAT003,AWSAT005
-			ExpectedError: regexp.MustCompile(`expected at least 2 resource parts`),
+			ExpectedError: regexp.MustCompile(`expected resource prefix instance-profile`),
 		},
 		{
 			TestName:      "invalid ARN resource prefix",
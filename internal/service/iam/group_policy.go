@@ -33,7 +33,7 @@ func ResourceGroupPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateDiagFunc: ValidPolicyDocument(verify.ValidIAMPolicyJSON, GroupPolicyLintConfig),
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
@@ -47,7 +47,7 @@ func ResourcePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateDiagFunc: ValidPolicyDocument(verify.ValidIAMPolicyJSON, ManagedPolicyLintConfig),
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
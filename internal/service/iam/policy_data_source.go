@@ -25,7 +25,7 @@ func DataSourcePolicy() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfType(ARNService, "policy"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -3,6 +3,7 @@ package iam_test
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -315,6 +316,29 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_mergeStatements(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+	expectedJSON := testAccPolicyDocumentMergeStatementsExpectedMinifiedJSON()
+	expectedSize := strconv.Itoa(len(expectedJSON))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentMergeStatementsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", expectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "size.%", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "size.managed_policy", expectedSize),
+					resource.TestCheckResourceAttr(dataSourceName, "size.service_control_policy", expectedSize),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentConfig = `
 data "aws_partition" "current" {}
 
@@ -1320,3 +1344,30 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+var testAccPolicyDocumentMergeStatementsConfig = `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  merge_statements = true
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::test/*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::test/*"]
+  }
+
+  statement {
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::test"]
+  }
+}
+`
+
+func testAccPolicyDocumentMergeStatementsExpectedMinifiedJSON() string {
+	return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:%[1]s:s3:::test/*"},{"Sid":"","Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:%[1]s:s3:::test"}]}`, acctest.Partition())
+}
@@ -0,0 +1,493 @@
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// PolicyLintConfig describes where a policy document is used, which determines the rules it is linted against.
+type PolicyLintConfig struct {
+	// MaxSize is the maximum number of non-whitespace characters in the policy. Zero means no limit.
+	MaxSize int
+	// MaxSizeAdjustable indicates that MaxSize is a default quota that can be raised, so exceeding it is only a warning.
+	MaxSizeAdjustable bool
+	// Principals is one of the policyPrincipals constants.
+	Principals policyPrincipals
+	// RequireResources indicates that every statement must have a Resource or NotResource element.
+	RequireResources bool
+	// SizeUnit is one of the policySizeUnit constants.
+	SizeUnit policySizeUnit
+}
+
+type policySizeUnit int
+
+const (
+	// IAM doesn't count whitespace towards policy size.
+	policySizeUnitNonWhitespaceCharacters policySizeUnit = iota
+	policySizeUnitCharacters
+	policySizeUnitBytes
+)
+
+type policyPrincipals int
+
+const (
+	policyPrincipalsOptional policyPrincipals = iota
+	policyPrincipalsRequired
+	policyPrincipalsProhibited
+)
+
+// Linting rules for the places that policy documents are used.
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+var (
+	GenericPolicyLintConfig = PolicyLintConfig{
+		Principals: policyPrincipalsOptional,
+	}
+
+	BucketPolicyLintConfig = PolicyLintConfig{
+		MaxSize:          20480,
+		Principals:       policyPrincipalsRequired,
+		RequireResources: true,
+		SizeUnit:         policySizeUnitBytes,
+	}
+
+	GroupPolicyLintConfig = PolicyLintConfig{
+		MaxSize:          5120,
+		Principals:       policyPrincipalsProhibited,
+		RequireResources: true,
+	}
+
+	ManagedPolicyLintConfig = PolicyLintConfig{
+		MaxSize:          6144,
+		Principals:       policyPrincipalsProhibited,
+		RequireResources: true,
+	}
+
+	RolePolicyLintConfig = PolicyLintConfig{
+		MaxSize:          10240,
+		Principals:       policyPrincipalsProhibited,
+		RequireResources: true,
+	}
+
+	// Organizations counts whitespace in policies created via the API.
+	ServiceControlPolicyLintConfig = PolicyLintConfig{
+		MaxSize:    5120,
+		Principals: policyPrincipalsProhibited,
+		SizeUnit:   policySizeUnitCharacters,
+	}
+
+	TrustPolicyLintConfig = PolicyLintConfig{
+		MaxSize:           2048,
+		MaxSizeAdjustable: true,
+		Principals:        policyPrincipalsRequired,
+	}
+
+	UserPolicyLintConfig = PolicyLintConfig{
+		MaxSize:          2048,
+		Principals:       policyPrincipalsProhibited,
+		RequireResources: true,
+	}
+)
+
+var (
+	policyActionRegexp           = regexp.MustCompile(`^[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+$`)
+	policyAccountIDRegexp        = regexp.MustCompile(`^\d{12}$`)
+	policyUniqueIDRegexp         = regexp.MustCompile(`^A[A-Z0-9]{15,127}$`)
+	policyServicePrincipalRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*\.(amazonaws\.com|amazonaws\.com\.cn|amazon\.com)$`)
+)
+
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+var policyPrincipalTypes = []string{
+	"*",
+	"AWS",
+	"CanonicalUser",
+	"Federated",
+	"Service",
+}
+
+// ValidPolicyDocument returns a validation function that checks that a policy document is valid JSON using the specified function
+// and then lints it against the specified configuration.
+func ValidPolicyDocument(validateJSON schema.SchemaValidateFunc, config PolicyLintConfig) schema.SchemaValidateDiagFunc {
+	validateJSONDiag := validation.ToDiagFunc(validateJSON)
+
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		if diags := validateJSONDiag(v, path); diags.HasError() {
+			return diags
+		}
+
+		value, ok := v.(string)
+
+		if !ok {
+			return nil
+		}
+
+		diags := LintPolicyDocument(value, config)
+
+		for i := range diags {
+			diags[i].AttributePath = path
+		}
+
+		return diags
+	}
+}
+
+// LintPolicyDocument checks a policy document's JSON against the specified configuration.
+// Errors are returned for problems that AWS is certain to reject, warnings for likely mistakes.
+// Documents that can't be parsed into the policy model aren't linted.
+func LintPolicyDocument(policy string, config PolicyLintConfig) diag.Diagnostics {
+	doc, err := parsePolicyDocument(policy)
+
+	if err != nil {
+		return nil
+	}
+
+	diags := doc.Lint(config)
+
+	if config.MaxSize > 0 {
+		if size := config.Size(policy); size > config.MaxSize {
+			detail := fmt.Sprintf("Policy is %d %s long; the maximum is %d.", size, config.SizeUnit, config.MaxSize)
+
+			if config.MaxSizeAdjustable {
+				diags = append(diags, policyLintWarning(detail+" The maximum is an adjustable quota."))
+			} else {
+				diags = append(diags, policyLintError(detail))
+			}
+		}
+	}
+
+	return diags
+}
+
+// Lint checks the policy document's statements against the specified configuration.
+func (s *IAMPolicyDoc) Lint(config PolicyLintConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s.Version != "" && s.Version != "2008-10-17" && s.Version != "2012-10-17" {
+		diags = append(diags, policyLintError(fmt.Sprintf("Version %q is not one of \"2008-10-17\", \"2012-10-17\".", s.Version)))
+	}
+
+	if len(s.Statements) == 0 {
+		diags = append(diags, policyLintWarning("Policy has no statements."))
+	}
+
+	for i, statement := range s.Statements {
+		if statement == nil {
+			continue
+		}
+
+		diags = append(diags, statement.lint(i, config)...)
+	}
+
+	return diags
+}
+
+func (s *IAMPolicyStatement) lint(index int, config PolicyLintConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statement := fmt.Sprintf("Statement %d", index)
+
+	if s.Sid != "" {
+		statement = fmt.Sprintf("Statement %q", s.Sid)
+	}
+
+	errorf := func(format string, a ...interface{}) {
+		diags = append(diags, policyLintError(statement+": "+fmt.Sprintf(format, a...)))
+	}
+	warnf := func(format string, a ...interface{}) {
+		diags = append(diags, policyLintWarning(statement+": "+fmt.Sprintf(format, a...)))
+	}
+
+	switch {
+	case s.Effect == "":
+		errorf("Effect is required.")
+	case s.Effect == "Allow", s.Effect == "Deny":
+	case strings.EqualFold(s.Effect, "Allow"), strings.EqualFold(s.Effect, "Deny"):
+		warnf("Effect %q should be \"Allow\" or \"Deny\".", s.Effect)
+	default:
+		errorf("Effect %q is not one of \"Allow\", \"Deny\".", s.Effect)
+	}
+
+	actions, notActions := policyStrings(s.Actions), policyStrings(s.NotActions)
+
+	switch {
+	case s.Actions == nil && s.NotActions == nil:
+		errorf("one of Action or NotAction is required.")
+	case s.Actions != nil && s.NotActions != nil:
+		errorf("only one of Action or NotAction can be specified.")
+	}
+
+	for _, l := range [][]string{actions, notActions} {
+		for _, action := range l {
+			if action != "*" && !policyActionRegexp.MatchString(action) {
+				errorf("action %q is not of the form \"service:Action\".", action)
+			}
+		}
+	}
+
+	resources, notResources := policyStrings(s.Resources), policyStrings(s.NotResources)
+
+	switch {
+	case s.Resources == nil && s.NotResources == nil:
+		if config.RequireResources {
+			errorf("one of Resource or NotResource is required.")
+		}
+	case s.Resources != nil && s.NotResources != nil:
+		errorf("only one of Resource or NotResource can be specified.")
+	}
+
+	for _, l := range [][]string{resources, notResources} {
+		for _, resource := range l {
+			if resource != "*" && !isPolicyARN(resource) {
+				errorf("resource %q is not an ARN or \"*\".", resource)
+			}
+		}
+	}
+
+	hasPrincipals := len(s.Principals) > 0 || len(s.NotPrincipals) > 0
+
+	switch {
+	case len(s.Principals) > 0 && len(s.NotPrincipals) > 0:
+		errorf("only one of Principal or NotPrincipal can be specified.")
+	case hasPrincipals && config.Principals == policyPrincipalsProhibited:
+		errorf("Principal and NotPrincipal are not supported in this type of policy.")
+	case !hasPrincipals && config.Principals == policyPrincipalsRequired:
+		errorf("one of Principal or NotPrincipal is required in this type of policy.")
+	}
+
+	for _, principal := range s.allPrincipals() {
+		if !policyStringInSlice(principal.Type, policyPrincipalTypes, false) {
+			errorf("principal type %q is not one of %s.", principal.Type, policyQuoteStrings(policyPrincipalTypes))
+			continue
+		}
+
+		for _, identifier := range policyStrings(principal.Identifiers) {
+			switch principal.Type {
+			case "AWS":
+				if identifier != "*" && !policyAccountIDRegexp.MatchString(identifier) && !policyUniqueIDRegexp.MatchString(identifier) && !isPolicyARN(identifier) {
+					warnf("AWS principal %q is not an account ID, ARN or \"*\".", identifier)
+				}
+			case "Service":
+				if identifier != "*" && !policyServicePrincipalRegexp.MatchString(identifier) {
+					warnf("Service principal %q is not a service domain name, e.g. \"ec2.amazonaws.com\".", identifier)
+				}
+			}
+		}
+	}
+
+	for _, condition := range s.Conditions {
+		if !isPolicyConditionOperator(condition.Test) {
+			errorf("condition operator %q is not valid.", condition.Test)
+		}
+
+		if condition.Variable == "" {
+			errorf("condition operator %q has an empty condition key.", condition.Test)
+		}
+	}
+
+	return diags
+}
+
+func (s *IAMPolicyStatement) allPrincipals() IAMPolicyStatementPrincipalSet {
+	principals := make(IAMPolicyStatementPrincipalSet, 0, len(s.Principals)+len(s.NotPrincipals))
+	principals = append(principals, s.Principals...)
+	principals = append(principals, s.NotPrincipals...)
+
+	return principals
+}
+
+// parsePolicyDocument parses a policy document's JSON into the policy model.
+// A single statement object is accepted in place of an array of statements.
+func parsePolicyDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	statements := bytes.TrimSpace(raw.Statement)
+
+	if len(statements) == 0 || bytes.Equal(statements, []byte("null")) {
+		return doc, nil
+	}
+
+	if statements[0] == '{' {
+		statement := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(statements, statement); err != nil {
+			return nil, err
+		}
+
+		doc.Statements = []*IAMPolicyStatement{statement}
+
+		return doc, nil
+	}
+
+	if err := json.Unmarshal(statements, &doc.Statements); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Size returns the size of a policy document in the units that its maximum size is measured in.
+func (config PolicyLintConfig) Size(policy string) int {
+	switch config.SizeUnit {
+	case policySizeUnitBytes:
+		return len(policy)
+	case policySizeUnitCharacters:
+		return utf8.RuneCountInString(policy)
+	}
+
+	var n int
+
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (unit policySizeUnit) String() string {
+	switch unit {
+	case policySizeUnitBytes:
+		return "bytes"
+	case policySizeUnitCharacters:
+		return "characters"
+	}
+
+	return "characters (excluding whitespace)"
+}
+
+func isPolicyARN(s string) bool {
+	// arn:partition:service:region:account-id:resource
+	return strings.HasPrefix(s, "arn:") && strings.Count(s, ":") >= 5
+}
+
+func isPolicyConditionOperator(s string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+			s = s[len(prefix):]
+			break
+		}
+	}
+
+	if policyStringInSlice(s, policyConditionOperators, true) {
+		return true
+	}
+
+	// IfExists can be added to any operator except Null.
+	if len(s) > len("IfExists") && strings.EqualFold(s[len(s)-len("IfExists"):], "IfExists") {
+		s = s[:len(s)-len("IfExists")]
+
+		return !strings.EqualFold(s, "Null") && policyStringInSlice(s, policyConditionOperators, true)
+	}
+
+	return false
+}
+
+// policyStrings returns the string values of a policy element, which may be a string or a list of strings.
+func policyStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+
+		return s
+	}
+
+	return nil
+}
+
+func policyStringInSlice(s string, l []string, ignoreCase bool) bool {
+	for _, v := range l {
+		if s == v || (ignoreCase && strings.EqualFold(s, v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyQuoteStrings(l []string) string {
+	quoted := make([]string, len(l))
+
+	for i, v := range l {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func policyLintError(detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Invalid IAM policy document",
+		Detail:   detail,
+	}
+}
+
+func policyLintWarning(detail string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Possible problem in IAM policy document",
+		Detail:   detail,
+	}
+}
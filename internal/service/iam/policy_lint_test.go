@@ -0,0 +1,220 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Policy           string
+		Config           PolicyLintConfig
+		ExpectedErrors   []string
+		ExpectedWarnings []string
+	}{
+		{
+			Name: "valid identity policy",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "ReadBucket",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject*", "s3:ListBucket"],
+    "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/${aws:username}/*"],
+    "Condition": {
+      "ForAnyValue:StringLike": {"s3:prefix": ["home/"]},
+      "IpAddressIfExists": {"aws:SourceIp": "203.0.113.0/24"}
+    }
+  }]
+}`,
+			Config: ManagedPolicyLintConfig,
+		},
+		{
+			Name: "valid trust policy with single statement object",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "sts:AssumeRole",
+    "Principal": {"Service": "ec2.amazonaws.com", "AWS": ["123456789012", "arn:aws:iam::123456789012:root"]}
+  }
+}`,
+			Config: TrustPolicyLintConfig,
+		},
+		{
+			Name: "invalid statement elements",
+			Policy: `{
+  "Version": "2012-10-18",
+  "Statement": [
+    {"Sid": "NoEffect", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "BadEffect", "Effect": "Permit", "Action": "s3GetObject", "NotAction": "s3:PutObject", "Resource": "bucket"},
+    {"Effect": "allow", "Action": "*"}
+  ]
+}`,
+			Config: ManagedPolicyLintConfig,
+			ExpectedErrors: []string{
+				`Version "2012-10-18"`,
+				`Statement "NoEffect": Effect is required`,
+				`Statement "BadEffect": Effect "Permit"`,
+				`Statement "BadEffect": only one of Action or NotAction`,
+				`Statement "BadEffect": action "s3GetObject"`,
+				`Statement "BadEffect": resource "bucket"`,
+				`Statement 2: one of Resource or NotResource is required`,
+			},
+			ExpectedWarnings: []string{
+				`Statement 2: Effect "allow"`,
+			},
+		},
+		{
+			Name: "principals",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Identity", "Effect": "Allow", "Action": "s3:*", "Resource": "*", "Principal": "*"}
+  ]
+}`,
+			Config: ManagedPolicyLintConfig,
+			ExpectedErrors: []string{
+				`Statement "Identity": Principal and NotPrincipal are not supported`,
+			},
+		},
+		{
+			Name: "principal types and shapes",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Trust", "Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {"Account": "123456789012", "AWS": "example", "Service": "ec2"}}
+  ]
+}`,
+			Config: TrustPolicyLintConfig,
+			ExpectedErrors: []string{
+				`Statement "Trust": principal type "Account"`,
+			},
+			ExpectedWarnings: []string{
+				`Statement "Trust": AWS principal "example"`,
+				`Statement "Trust": Service principal "ec2"`,
+			},
+		},
+		{
+			Name: "missing principal",
+			Policy: `{
+  "Statement": [
+    {"Sid": "Trust", "Effect": "Allow", "Action": "sts:AssumeRole"}
+  ]
+}`,
+			Config: TrustPolicyLintConfig,
+			ExpectedErrors: []string{
+				`Statement "Trust": one of Principal or NotPrincipal is required`,
+			},
+		},
+		{
+			Name: "condition operators",
+			Policy: `{
+  "Statement": [
+    {
+      "Sid": "Conditions",
+      "Effect": "Deny",
+      "Action": "*",
+      "Resource": "*",
+      "Condition": {
+        "StringEqual": {"aws:RequestedRegion": "us-west-2"},
+        "NullIfExists": {"aws:TokenIssueTime": "true"},
+        "forallvalues:stringequals": {"aws:TagKeys": ["Name"]}
+      }
+    }
+  ]
+}`,
+			Config: ServiceControlPolicyLintConfig,
+			ExpectedErrors: []string{
+				`Statement "Conditions": condition operator "StringEqual"`,
+				`Statement "Conditions": condition operator "NullIfExists"`,
+			},
+		},
+		{
+			Name:   "size",
+			Policy: fmt.Sprintf(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::%s"}]}`, strings.Repeat("a", 2048)),
+			Config: UserPolicyLintConfig,
+			ExpectedErrors: []string{
+				"the maximum is 2048",
+			},
+		},
+		{
+			Name:   "adjustable size",
+			Policy: fmt.Sprintf(`{"Statement": [{"Sid": "%s", "Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {"AWS": "*"}}]}`, strings.Repeat("a", 2048)),
+			Config: TrustPolicyLintConfig,
+			ExpectedWarnings: []string{
+				"adjustable quota",
+			},
+		},
+		{
+			Name:   "not a policy document",
+			Policy: `{"Statement": "invalid"}`,
+			Config: ManagedPolicyLintConfig,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			diags := LintPolicyDocument(testCase.Policy, testCase.Config)
+
+			testPolicyLintDiagnostics(t, diags, diag.Error, testCase.ExpectedErrors)
+			testPolicyLintDiagnostics(t, diags, diag.Warning, testCase.ExpectedWarnings)
+		})
+	}
+}
+
+func TestValidPolicyDocument(t *testing.T) {
+	path := cty.GetAttrPath("policy")
+	f := ValidPolicyDocument(validation.StringIsJSON, ManagedPolicyLintConfig)
+
+	diags := f(`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject"}]}`, path)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	for _, d := range diags {
+		if !d.AttributePath.Equals(path) {
+			t.Errorf("got attribute path %#v, expected %#v", d.AttributePath, path)
+		}
+	}
+
+	if diags := f(`{"Statement": [`, path); len(diags) != 1 || !diags.HasError() {
+		t.Errorf("expected one JSON error, got %#v", diags)
+	}
+}
+
+func testPolicyLintDiagnostics(t *testing.T, diags diag.Diagnostics, severity diag.Severity, expected []string) {
+	t.Helper()
+
+	var details []string
+
+	for _, d := range diags {
+		if d.Severity == severity {
+			details = append(details, d.Detail)
+		}
+	}
+
+	if len(details) != len(expected) {
+		t.Fatalf("got %d diagnostics with severity %d (%q), expected %d", len(details), severity, details, len(expected))
+	}
+
+	for _, want := range expected {
+		var found bool
+
+		for _, detail := range details {
+			if strings.Contains(detail, want) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("no diagnostic with severity %d contains %q: %q", severity, want, details)
+		}
+	}
+}
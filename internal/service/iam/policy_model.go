@@ -70,6 +70,182 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MergeStatements combines statements that differ only in their actions or only in their resources.
+// Statements are combined if they have the same effect, principals and conditions and either
+// the same actions, in which case their resources are unioned, or the same resources, in which case their actions are unioned.
+// Statements with a Sid are never combined as they may be referenced by other documents.
+// Statements that aren't combined are merged into the result with Merge, so a statement replaces any earlier one with the same Sid.
+func (s *IAMPolicyDoc) MergeStatements() error {
+	for {
+		merged := &IAMPolicyDoc{
+			Id:      s.Id,
+			Version: s.Version,
+		}
+
+		for _, statement := range s.Statements {
+			combined, err := merged.combineStatement(statement)
+
+			if err != nil {
+				return err
+			}
+
+			if !combined {
+				merged.Merge(&IAMPolicyDoc{Statements: []*IAMPolicyStatement{statement}})
+			}
+		}
+
+		// Combining statements can make others combinable, so repeat until no more are.
+		done := len(merged.Statements) == len(s.Statements)
+		s.Statements = merged.Statements
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// combineStatement combines the statement into the first of the document's statements it can be combined with,
+// returning whether it was.
+func (s *IAMPolicyDoc) combineStatement(other *IAMPolicyStatement) (bool, error) {
+	for _, statement := range s.Statements {
+		ok, err := statement.mergeable(other)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		switch {
+		case policyElementEqual(statement.Actions, other.Actions) && policyElementEqual(statement.NotActions, other.NotActions):
+			statement.Resources = policyElementUnion(statement.Resources, other.Resources)
+			statement.NotResources = policyElementUnion(statement.NotResources, other.NotResources)
+		default:
+			statement.Actions = policyElementUnion(statement.Actions, other.Actions)
+			statement.NotActions = policyElementUnion(statement.NotActions, other.NotActions)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// mergeable returns whether the two statements can be combined without changing the permissions they grant or deny.
+func (s *IAMPolicyStatement) mergeable(other *IAMPolicyStatement) (bool, error) {
+	if s.Sid != "" || other.Sid != "" || s.Effect != other.Effect {
+		return false, nil
+	}
+
+	// Statements can only be combined if they use the same elements, e.g. both Action or both NotAction.
+	if (s.Actions == nil) != (other.Actions == nil) || (s.NotActions == nil) != (other.NotActions == nil) ||
+		(s.Resources == nil) != (other.Resources == nil) || (s.NotResources == nil) != (other.NotResources == nil) {
+		return false, nil
+	}
+
+	sameActions := policyElementEqual(s.Actions, other.Actions) && policyElementEqual(s.NotActions, other.NotActions)
+	sameResources := policyElementEqual(s.Resources, other.Resources) && policyElementEqual(s.NotResources, other.NotResources)
+
+	// NotAction and NotResource elements can't be unioned without granting or denying less.
+	switch {
+	case sameActions && (s.NotResources == nil || sameResources):
+	case sameResources && s.NotActions == nil:
+	default:
+		return false, nil
+	}
+
+	for _, v := range [][2]interface{}{
+		{s.Principals, other.Principals},
+		{s.NotPrincipals, other.NotPrincipals},
+		{s.Conditions, other.Conditions},
+	} {
+		equal, err := policyJSONEqual(v[0], v[1])
+
+		if err != nil {
+			return false, err
+		}
+
+		if !equal {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func policyJSONEqual(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+
+	if err != nil {
+		return false, err
+	}
+
+	bJSON, err := json.Marshal(b)
+
+	if err != nil {
+		return false, err
+	}
+
+	return string(aJSON) == string(bJSON), nil
+}
+
+// policyElementEqual returns whether two Action or Resource element values contain the same strings.
+func policyElementEqual(a, b interface{}) bool {
+	aStrings, bStrings := policyElementStrings(a), policyElementStrings(b)
+
+	if len(aStrings) != len(bStrings) {
+		return false
+	}
+
+	for i := range aStrings {
+		if aStrings[i] != bStrings[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// policyElementUnion returns an Action or Resource element value containing the strings in either value.
+func policyElementUnion(a, b interface{}) interface{} {
+	if a == nil && b == nil {
+		return nil
+	}
+
+	var union []string
+	union = append(union, policyStrings(a)...)
+	union = append(union, policyStrings(b)...)
+
+	var l []interface{}
+
+	for _, v := range policyElementStrings(union) {
+		l = append(l, v)
+	}
+
+	return iamPolicyDecodeConfigStringList(l)
+}
+
+// policyElementStrings returns the sorted, unique strings in an Action or Resource element value.
+func policyElementStrings(v interface{}) []string {
+	seen := make(map[string]struct{})
+	var l []string
+
+	for _, v := range policyStrings(v) {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		l = append(l, v)
+	}
+
+	sort.Strings(l)
+
+	return l
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
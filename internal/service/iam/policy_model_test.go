@@ -0,0 +1,99 @@
+package iam
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIAMPolicyDocMergeStatements(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "same resources",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"},{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::test/*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::test/*"}]}`,
+		},
+		{
+			Name:     "same actions",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test1/*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test2/*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::test2/*","arn:aws:s3:::test1/*"]}]}`,
+		},
+		{
+			Name:     "different actions and resources",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test1/*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::test2/*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test1/*"},{"Sid":"","Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::test2/*"}]}`,
+		},
+		{
+			Name:     "transitive",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test1"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test2"},{"Effect":"Allow","Action":"s3:PutObject","Resource":["arn:aws:s3:::test1","arn:aws:s3:::test2"]}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::test2","arn:aws:s3:::test1"]}]}`,
+		},
+		{
+			Name:     "different effects",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:     "different conditions",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["true"]}}},{"Sid":"","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:     "same principals",
+			Input:    `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":"ec2.amazonaws.com"}},{"Effect":"Allow","Action":"sts:TagSession","Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Allow","Action":["sts:TagSession","sts:AssumeRole"],"Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+		},
+		{
+			Name:     "Sids",
+			Input:    `{"Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:     "same Sid",
+			Input:    `{"Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"},{"Sid":"Get","Effect":"Allow","Action":"s3:GetObjectVersion","Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObjectVersion","Resource":"*"},{"Sid":"","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:     "Id and Version",
+			Input:    `{"Version":"2012-10-17","Id":"test","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Id":"test","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+		},
+		{
+			Name:     "NotAction",
+			Input:    `{"Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"arn:aws:s3:::test1"},{"Effect":"Deny","NotAction":"s3:*","Resource":"arn:aws:s3:::test1"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Deny","NotAction":"iam:*","Resource":"arn:aws:s3:::test1"},{"Sid":"","Effect":"Deny","NotAction":"s3:*","Resource":"arn:aws:s3:::test1"}]}`,
+		},
+		{
+			Name:     "NotResource",
+			Input:    `{"Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::test1"},{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::test2"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::test1"},{"Sid":"","Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::test2"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &IAMPolicyDoc{}
+
+			if err := json.Unmarshal([]byte(testCase.Input), doc); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err := doc.MergeStatements(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := json.Marshal(doc)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
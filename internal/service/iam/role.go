@@ -78,7 +78,7 @@ func ResourceRole() *schema.Resource {
 			"permissions_boundary": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOfType(ARNService, "policy"),
 			},
 
 			"description": {
@@ -95,7 +95,7 @@ func ResourceRole() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				ValidateFunc:     validation.StringIsJSON,
+				ValidateDiagFunc: ValidPolicyDocument(validation.StringIsJSON, TrustPolicyLintConfig),
 			},
 
 			"force_detach_policies": {
@@ -136,7 +136,7 @@ func ResourceRole() *schema.Resource {
 						"policy": {
 							Type:             schema.TypeString,
 							Optional:         true, // semantically required but syntactically optional to allow empty inline_policy
-							ValidateFunc:     verify.ValidIAMPolicyJSON,
+							ValidateDiagFunc: ValidPolicyDocument(verify.ValidIAMPolicyJSON, RolePolicyLintConfig),
 							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 						},
 					},
@@ -156,7 +156,7 @@ func ResourceRole() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: verify.ValidARN,
+					ValidateFunc: verify.ValidARNOfType(ARNService, "policy"),
 				},
 			},
 		},
@@ -37,7 +37,7 @@ func ResourceRolePolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateDiagFunc: ValidPolicyDocument(verify.ValidIAMPolicyJSON, RolePolicyLintConfig),
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
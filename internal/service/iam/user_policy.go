@@ -32,7 +32,7 @@ func ResourceUserPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				ValidateDiagFunc: ValidPolicyDocument(verify.ValidIAMPolicyJSON, UserPolicyLintConfig),
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 			"name": {
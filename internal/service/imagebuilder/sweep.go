@@ -394,7 +394,7 @@ func sweepImages(region string) error {
 					d := r.Data(nil)
 					d.SetId(imageBuildVersionArn)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_imagebuilder_image", r, d, client))
 				}
 
 				return !lastPage
@@ -96,7 +96,7 @@ func sweepCertifcates(region string) error {
 
 			d.SetId(aws.StringValue(certificate.CertificateId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_certificate", r, d, client))
 		}
 
 		return !lastPage
@@ -154,7 +154,7 @@ func sweepPolicyAttachments(region string) error {
 					d.Set("policy", policy.PolicyName)
 					d.Set("target", target)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_policy_attachment", r, d, client))
 				}
 
 				return !lastPage
@@ -208,7 +208,7 @@ func sweepPolicies(region string) error {
 
 			d.SetId(aws.StringValue(policy.PolicyName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_policy", r, d, client))
 		}
 
 		return !lastPage
@@ -254,7 +254,7 @@ func sweepRoleAliases(region string) error {
 
 			d.SetId(aws.StringValue(roleAlias))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_role_alias", r, d, client))
 		}
 
 		return !lastPage
@@ -312,7 +312,7 @@ func sweepThingPrincipalAttachments(region string) error {
 					d.Set("principal", principal)
 					d.Set("thing", thing.ThingName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing_principal_attachment", r, d, client))
 				}
 
 				return !lastPage
@@ -366,7 +366,7 @@ func sweepThings(region string) error {
 
 			d.SetId(aws.StringValue(thing.ThingName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing", r, d, client))
 		}
 
 		return !lastPage
@@ -412,7 +412,7 @@ func sweepThingTypes(region string) error {
 
 			d.SetId(aws.StringValue(thingTypes.ThingTypeName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing_type", r, d, client))
 		}
 
 		return !lastPage
@@ -500,7 +500,7 @@ func sweepThingGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(group.GroupName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing_group", r, d, client))
 		}
 
 		return !lastPage
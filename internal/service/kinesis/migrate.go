@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -56,7 +57,7 @@ func resourceStreamResourceV0() *schema.Resource {
 }
 
 func StreamStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	rawState["enforce_consumer_deletion"] = false
-
-	return rawState, nil
+	return stateupgrade.Apply(rawState,
+		stateupgrade.Default("enforce_consumer_deletion", false),
+	)
 }
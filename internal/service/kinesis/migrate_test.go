@@ -2,10 +2,12 @@ package kinesis_test
 
 import (
 	"context"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	tfkinesis "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
 )
 
 func testResourceStreamStateDataV0() map[string]interface{} {
@@ -47,3 +49,7 @@ func TestStreamStateUpgradeV0(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
 	}
 }
+
+func TestStreamStateUpgradeGolden(t *testing.T) {
+	stateupgrade.CheckGolden(t, tfkinesis.ResourceStream(), filepath.Join("testdata", "state", "stream"))
+}
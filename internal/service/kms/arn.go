@@ -2,19 +2,19 @@ package kms
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfarn"
 )
 
 const (
-	ARNSeparator = "/"
-	ARNService   = "kms"
+	ARNService = "kms"
+
+	KeyResourceType = "key"
 )
 
 // AliasARNToKeyARN converts an alias ARN to a CMK ARN.
 func AliasARNToKeyARN(inputARN, keyID string) (string, error) {
-	parsedARN, err := arn.Parse(inputARN)
+	parsedARN, err := tfarn.Parse(inputARN)
 
 	if err != nil {
 		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
@@ -24,15 +24,13 @@ func AliasARNToKeyARN(inputARN, keyID string) (string, error) {
 		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
 	}
 
-	outputARN := arn.ARN{
-		Partition: parsedARN.Partition,
-		Service:   parsedARN.Service,
-		Region:    parsedARN.Region,
-		AccountID: parsedARN.AccountID,
-		Resource:  strings.Join([]string{"key", keyID}, ARNSeparator),
-	}.String()
+	outputARN, err := tfarn.New(parsedARN.Partition, parsedARN.Service, parsedARN.Region, parsedARN.AccountID, KeyResourceType, keyID)
+
+	if err != nil {
+		return "", err
+	}
 
-	return outputARN, nil
+	return outputARN.String(), nil
 }
 
 // KeyARNOrIDEqual returns whether two CMK ARNs or IDs are equal.
@@ -43,17 +41,17 @@ func KeyARNOrIDEqual(arnOrID1, arnOrID2 string) bool {
 
 	// Key ARN: arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab
 	// Key ID: 1234abcd-12ab-34cd-56ef-1234567890ab
-	arn1, err := arn.Parse(arnOrID1)
+	arn1, err := tfarn.Parse(arnOrID1)
 	firstIsARN := err == nil
-	arn2, err := arn.Parse(arnOrID2)
+	arn2, err := tfarn.Parse(arnOrID2)
 	secondIsARN := err == nil
 
 	if firstIsARN && !secondIsARN {
-		return arn1.Resource == "key/"+arnOrID2
+		return arn1.Is(ARNService, KeyResourceType) && arn1.ResourceID == arnOrID2
 	}
 
 	if secondIsARN && !firstIsARN {
-		return arn2.Resource == "key/"+arnOrID1
+		return arn2.Is(ARNService, KeyResourceType) && arn2.ResourceID == arnOrID1
 	}
 
 	return false
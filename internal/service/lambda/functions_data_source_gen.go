@@ -0,0 +1,101 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=Functions -DataSourceDescription=Lambda Function -DataSourceListOp=ListFunctions -DataSourceItems=Functions -DataSourceIDField=FunctionName -DataSourceARNField=FunctionArn -DataSourceNameField=FunctionName -DataSourceListTagsIDField=FunctionArn"; DO NOT EDIT.
+
+package lambda
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFunctions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceFunctionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &lambda.ListFunctionsInput{}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var arns, ids, names []string
+	var itemErr error
+
+	err := conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Functions {
+			if v == nil {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, aws.StringValue(v.FunctionArn))
+
+				if err != nil {
+					itemErr = fmt.Errorf("error listing tags for Lambda Function (%s): %w", aws.StringValue(v.FunctionArn), err)
+
+					return false
+				}
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			id := aws.StringValue(v.FunctionName)
+			name := aws.StringValue(v.FunctionName)
+			arn := aws.StringValue(v.FunctionArn)
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Functions: %w", err)
+	}
+
+	if itemErr != nil {
+		return itemErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTags -ListTagsInIDElem=Resource -ServiceTagsMap -TagInIDElem=Resource -UpdateTags
+//go:generate go run -tags generate ../../generate/listpages/main.go -DataSource=Functions "-DataSourceDescription=Lambda Function" -DataSourceListOp=ListFunctions -DataSourceItems=Functions -DataSourceIDField=FunctionName -DataSourceARNField=FunctionArn -DataSourceNameField=FunctionName -DataSourceListTagsIDField=FunctionArn
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package lambda
@@ -76,7 +76,7 @@ func sweepBotAliases(region string) error {
 					d.Set("bot_name", bot.Name)
 					d.Set("name", botAlias.Name)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_lex_bot_alias", r, d, client))
 				}
 
 				return !lastPage
@@ -91,7 +91,7 @@ func sweepBotAliases(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_lex_bot_alias", r, d, client))
 		}
 
 		return !lastPage
@@ -137,7 +137,7 @@ func sweepBots(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_lex_bot", r, d, client))
 		}
 
 		return !lastPage
@@ -183,7 +183,7 @@ func sweepIntents(region string) error {
 
 			d.SetId(aws.StringValue(intent.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_lex_intent", r, d, client))
 		}
 
 		return !lastPage
@@ -229,7 +229,7 @@ func sweepSlotTypes(region string) error {
 
 			d.SetId(aws.StringValue(slotType.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_lex_slot_type", r, d, client))
 		}
 
 		return !lastPage
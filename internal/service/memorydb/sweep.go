@@ -87,7 +87,7 @@ func sweepACLs(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_acl", r, d, client))
 		}
 
 		return !lastPage
@@ -130,7 +130,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_cluster", r, d, client))
 		}
 
 		return !lastPage
@@ -179,7 +179,7 @@ func sweepParameterGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_parameter_group", r, d, client))
 		}
 
 		return !lastPage
@@ -222,7 +222,7 @@ func sweepSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_snapshot", r, d, client))
 		}
 
 		return !lastPage
@@ -271,7 +271,7 @@ func sweepSubnetGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_subnet_group", r, d, client))
 		}
 
 		return !lastPage
@@ -320,7 +320,7 @@ func sweepUsers(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_memorydb_user", r, d, client))
 		}
 
 		return !lastPage
@@ -3,8 +3,8 @@ package meta
 import (
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfarn"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -38,13 +38,21 @@ func DataSourceARN() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceARNRead(d *schema.ResourceData, meta interface{}) error {
 	v := d.Get("arn").(string)
-	arn, err := arn.Parse(v)
+	arn, err := tfarn.Parse(v)
 	if err != nil {
 		return fmt.Errorf("Error parsing '%s': %w", v, err)
 	}
@@ -55,6 +63,8 @@ func dataSourceARNRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("region", arn.Region)
 	d.Set("account", arn.AccountID)
 	d.Set("resource", arn.Resource)
+	d.Set("resource_id", arn.ResourceID)
+	d.Set("resource_type", arn.ResourceType)
 
 	return nil
 }
@@ -36,6 +36,8 @@ func TestAccMetaARNDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "partition", testARN.Partition),
 					resource.TestCheckResourceAttr(resourceName, "region", testARN.Region),
 					resource.TestCheckResourceAttr(resourceName, "resource", testARN.Resource),
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "mysql-db"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "db"),
 					resource.TestCheckResourceAttr(resourceName, "service", testARN.Service),
 				),
 			},
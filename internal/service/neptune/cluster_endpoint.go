@@ -103,7 +103,7 @@ func resourceClusterEndpointCreate(d *schema.ResourceData, meta interface{}) err
 
 	clusterId := aws.StringValue(out.DBClusterIdentifier)
 	endpointId := aws.StringValue(out.DBClusterEndpointIdentifier)
-	d.SetId(fmt.Sprintf("%s:%s", clusterId, endpointId))
+	d.SetId(clusterEndpointCreateID(clusterId, endpointId))
 
 	_, err = WaitDBClusterEndpointAvailable(conn, d.Id())
 	if err != nil {
@@ -1,14 +1,21 @@
 package neptune
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
-func readClusterEndpointID(id string) (clusterIdentifier string, endpointIndetifer string, err error) {
-	idParts := strings.Split(id, ":")
-	if len(idParts) != 2 {
-		return "", "", fmt.Errorf("expected ID in format clusterIdentifier:endpointIndetifer, received: %s", id)
+var clusterEndpointIDFormat = tfid.NewFormat(":", "clusterIdentifier", "endpointIdentifier")
+
+func clusterEndpointCreateID(clusterIdentifier, endpointIdentifier string) string {
+	return clusterEndpointIDFormat.ID(clusterIdentifier, endpointIdentifier)
+}
+
+func readClusterEndpointID(id string) (clusterIdentifier string, endpointIdentifier string, err error) {
+	parts, err := clusterEndpointIDFormat.Parse(id)
+
+	if err != nil {
+		return "", "", err
 	}
-	return idParts[0], idParts[1], nil
+
+	return parts[0], parts[1], nil
 }
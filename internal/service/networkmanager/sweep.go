@@ -82,7 +82,7 @@ func sweepGlobalNetworks(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.GlobalNetworkId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_global_network", r, d, client))
 		}
 
 		return !lastPage
@@ -137,7 +137,7 @@ func sweepSites(region string) error {
 					d.SetId(aws.StringValue(v.SiteId))
 					d.Set("global_network_id", v.GlobalNetworkId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_site", r, d, client))
 				}
 
 				return !lastPage
@@ -204,7 +204,7 @@ func sweepDevices(region string) error {
 					d.SetId(aws.StringValue(v.DeviceId))
 					d.Set("global_network_id", v.GlobalNetworkId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_device", r, d, client))
 				}
 
 				return !lastPage
@@ -271,7 +271,7 @@ func sweepLinks(region string) error {
 					d.SetId(aws.StringValue(v.LinkId))
 					d.Set("global_network_id", v.GlobalNetworkId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_link", r, d, client))
 				}
 
 				return !lastPage
@@ -337,7 +337,7 @@ func sweepLinkAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(LinkAssociationCreateResourceID(aws.StringValue(v.GlobalNetworkId), aws.StringValue(v.LinkId), aws.StringValue(v.DeviceId)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_link_association", r, d, client))
 				}
 
 				return !lastPage
@@ -404,7 +404,7 @@ func sweepConnections(region string) error {
 					d.SetId(aws.StringValue(v.ConnectionId))
 					d.Set("global_network_id", v.GlobalNetworkId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_networkmanager_connection", r, d, client))
 				}
 
 				return !lastPage
@@ -102,7 +102,7 @@ func sweepApplication(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(app.AppId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_application", r, d, client))
 		}
 	}
 
@@ -154,7 +154,7 @@ func sweepInstance(region string) error {
 			d.SetId(aws.StringValue(instance.InstanceId))
 			d.Set("status", instance.Status)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_instance", r, d, client))
 		}
 	}
 
@@ -206,7 +206,7 @@ func sweepRDSDBInstance(region string) error {
 			d.SetId(aws.StringValue(dbInstance.DbInstanceIdentifier))
 			d.Set("rds_db_instance_arn", dbInstance.RdsDbInstanceArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_rds_db_instance", r, d, client))
 		}
 	}
 
@@ -249,7 +249,7 @@ func sweepStacks(region string) error {
 			d.Set("use_opsworks_security_groups", true)
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_stack", r, d, client))
 	}
 
 	return sweep.SweepOrchestrator(sweepResources)
@@ -309,7 +309,7 @@ func sweepLayers(region string) error {
 				}
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_ecs_cluster_layer", r, d, client))
 		}
 	}
 
@@ -339,7 +339,7 @@ func sweepUserProfiles(region string) error {
 		r := ResourceUserProfile()
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(profile.IamUserArn))
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_opsworks_user_profile", r, d, client))
 	}
 
 	return sweep.SweepOrchestrator(sweepResources)
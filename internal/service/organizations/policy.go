@@ -4,16 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -59,10 +62,37 @@ func ResourcePolicy() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyContentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// resourcePolicyContentCustomizeDiff lints the content of service control policies, which are IAM policy documents.
+// Other policy types have their own syntax.
+func resourcePolicyContentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("type").(string) != organizations.PolicyTypeServiceControlPolicy || !diff.NewValueKnown("content") {
+		return nil
+	}
+
+	var errs []string
+
+	for _, d := range tfiam.LintPolicyDocument(diff.Get("content").(string), tfiam.ServiceControlPolicyLintConfig) {
+		if d.Severity == diag.Error {
+			errs = append(errs, d.Detail)
+		} else {
+			log.Printf("[WARN] Organizations policy (%s) content: %s", diff.Get("name").(string), d.Detail)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid service control policy content: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -57,7 +57,7 @@ func sweepsDataSource(region string) error {
 
 			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(ds.DataSourceId)))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_quicksight_data_source", r, d, client))
 		}
 
 		return !lastPage
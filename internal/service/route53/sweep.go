@@ -77,7 +77,7 @@ func sweepHealthchecks(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53_health_check", r, d, client))
 		}
 
 		return !lastPage
@@ -154,7 +154,7 @@ func sweepKeySigningKeys(region string) error {
 				d.Set("name", dns.Name)
 				d.Set("status", dns.Status)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53_key_signing_key", r, d, client))
 			}
 
 		}
@@ -260,7 +260,7 @@ func sweepZones(region string) error {
 			d.Set("force_destroy", true)
 			d.Set("name", detail.Name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53_zone", r, d, client))
 		}
 
 		return !lastPage
@@ -63,7 +63,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.ClusterArn))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53recoverycontrolconfig_cluster", r, d, client))
 		}
 
 		return !lastPage
@@ -121,7 +121,7 @@ func sweepControlPanels(region string) error {
 					d := r.Data(nil)
 					d.SetId(aws.StringValue(v.ControlPanelArn))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53recoverycontrolconfig_control_panel", r, d, client))
 				}
 
 				return !lastPage
@@ -197,7 +197,7 @@ func sweepRoutingControls(region string) error {
 							d := r.Data(nil)
 							d.SetId(aws.StringValue(v.RoutingControlArn))
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53recoverycontrolconfig_routing_control", r, d, client))
 						}
 
 						return !lastPage
@@ -291,7 +291,7 @@ func sweepSafetyRules(region string) error {
 								continue
 							}
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource("aws_route53recoverycontrolconfig_safety_rule", r, d, client))
 						}
 
 						return !lastPage
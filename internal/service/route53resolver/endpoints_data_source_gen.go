@@ -0,0 +1,109 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=Endpoints -DataSourceDescription=Route53 Resolver Endpoint -DataSourceListOp=ListResolverEndpoints -DataSourceItems=ResolverEndpoints -DataSourceIDField=Id -DataSourceARNField=Arn -DataSourceNameField=Name -DataSourceFilters=Route53resolverFilters -DataSourceListTagsIDField=Arn"; DO NOT EDIT.
+
+package route53resolver
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": namevaluesfilters.Schema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &route53resolver.ListResolverEndpointsInput{}
+
+	filters := namevaluesfilters.New(d.Get("filter").(*schema.Set))
+
+	if len(filters) > 0 {
+		input.Filters = filters.Route53resolverFilters()
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var arns, ids, names []string
+	var itemErr error
+
+	err := conn.ListResolverEndpointsPages(input, func(page *route53resolver.ListResolverEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResolverEndpoints {
+			if v == nil {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, aws.StringValue(v.Arn))
+
+				if err != nil {
+					itemErr = fmt.Errorf("error listing tags for Route53 Resolver Endpoint (%s): %w", aws.StringValue(v.Arn), err)
+
+					return false
+				}
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			id := aws.StringValue(v.Id)
+			name := aws.StringValue(v.Name)
+			arn := aws.StringValue(v.Arn)
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Route53 Resolver Endpoints: %w", err)
+	}
+
+	if itemErr != nil {
+		return itemErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package route53resolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ResolverEndpointsDataSource_filter(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_route53_resolver_endpoint.foo"
+	datasourceName := "data.aws_route53_resolver_endpoints.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRoute53ResolverEndpointsConfig_filter(rInt, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRoute53ResolverEndpointsConfig_filter(rInt int, name string) string {
+	return acctest.ConfigCompose(testAccDataSourceRoute53ResolverEndpointConfig_base(rInt), fmt.Sprintf(`
+resource "aws_route53_resolver_endpoint" "foo" {
+  direction = "INBOUND"
+  name      = %[1]q
+
+  security_group_ids = [
+    aws_security_group.sg1.id,
+    aws_security_group.sg2.id,
+  ]
+
+  ip_address {
+    subnet_id = aws_subnet.sn1.id
+  }
+
+  ip_address {
+    subnet_id = aws_subnet.sn2.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_route53_resolver_endpoints" "foo" {
+  filter {
+    name   = "Name"
+    values = [aws_route53_resolver_endpoint.foo.name]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, name))
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run -tags generate ../../generate/listpages/main.go -DataSource=Endpoints "-DataSourceDescription=Route53 Resolver Endpoint" -DataSourceListOp=ListResolverEndpoints -DataSourceItems=ResolverEndpoints -DataSourceIDField=Id -DataSourceARNField=Arn -DataSourceNameField=Name -DataSourceFilters=Route53resolverFilters -DataSourceListTagsIDField=Arn
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package route53resolver
@@ -65,7 +65,7 @@ func sweepAccessPoints(region string) error {
 			}
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3_access_point", r, d, client))
 		}
 
 		return !lastPage
@@ -123,7 +123,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(MultiRegionAccessPointCreateResourceID(accountID, aws.StringValue(accessPoint.Name)))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3control_multi_region_access_point", r, d, client))
 		}
 
 		return !lastPage
@@ -169,7 +169,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(ObjectLambdaAccessPointCreateResourceID(accountID, aws.StringValue(accessPoint.Name)))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3control_object_lambda_access_point", r, d, client))
 		}
 
 		return !lastPage
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTagsInIDElem=SecretId -ServiceTagsSlice -TagInIDElem=SecretId -UpdateTags
+//go:generate go run -tags generate ../../generate/listpages/main.go -DataSource=Secrets "-DataSourceDescription=Secrets Manager Secret" -DataSourceListOp=ListSecrets -DataSourceItems=SecretList -DataSourceIDField=ARN -DataSourceARNField=ARN -DataSourceNameField=Name -DataSourceTagsField=Tags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package secretsmanager
@@ -0,0 +1,90 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=Secrets -DataSourceDescription=Secrets Manager Secret -DataSourceListOp=ListSecrets -DataSourceItems=SecretList -DataSourceIDField=ARN -DataSourceARNField=ARN -DataSourceNameField=Name -DataSourceTagsField=Tags"; DO NOT EDIT.
+
+package secretsmanager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSecrets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecretsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &secretsmanager.ListSecretsInput{}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var arns, ids, names []string
+
+	err := conn.ListSecretsPages(input, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecretList {
+			if v == nil {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags := KeyValueTags(v.Tags)
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			id := aws.StringValue(v.ARN)
+			name := aws.StringValue(v.Name)
+			arn := aws.StringValue(v.ARN)
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secrets: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
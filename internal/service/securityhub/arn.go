@@ -4,17 +4,19 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfarn"
 )
 
 const (
-	ARNSeparator = "/"
-	ARNService   = "securityhub"
+	ARNService = "securityhub"
+
+	ControlResourceType      = "control"
+	SubscriptionResourceType = "subscription"
 )
 
 // StandardsControlARNToStandardsSubscriptionARN converts a security standard control ARN to a subscription ARN.
 func StandardsControlARNToStandardsSubscriptionARN(inputARN string) (string, error) {
-	parsedARN, err := arn.Parse(inputARN)
+	parsedARN, err := tfarn.Parse(inputARN)
 
 	if err != nil {
 		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
@@ -24,21 +26,22 @@ func StandardsControlARNToStandardsSubscriptionARN(inputARN string) (string, err
 		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
 	}
 
-	inputResourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+	if actual, expected := parsedARN.ResourceType, ControlResourceType; actual != expected {
+		return "", fmt.Errorf("expected resource type %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	// The control's path is the standard, e.g. "/cis-aws-foundations-benchmark/v/1.2.0/".
+	standard := strings.Trim(parsedARN.ResourcePath, "/")
 
-	if actual, expected := len(inputResourceParts), 3; actual < expected {
-		return "", fmt.Errorf("expected at least %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	if standard == "" {
+		return "", fmt.Errorf("expected standard in ARN (%s)", inputARN)
 	}
 
-	outputResourceParts := append([]string{"subscription"}, inputResourceParts[1:len(inputResourceParts)-1]...)
+	outputARN, err := tfarn.New(parsedARN.Partition, parsedARN.Service, parsedARN.Region, parsedARN.AccountID, SubscriptionResourceType, standard)
 
-	outputARN := arn.ARN{
-		Partition: parsedARN.Partition,
-		Service:   parsedARN.Service,
-		Region:    parsedARN.Region,
-		AccountID: parsedARN.AccountID,
-		Resource:  strings.Join(outputResourceParts, ARNSeparator),
-	}.String()
+	if err != nil {
+		return "", err
+	}
 
-	return outputARN, nil
+	return outputARN.String(), nil
 }
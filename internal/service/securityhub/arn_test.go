@@ -32,7 +32,7 @@ func TestStandardsControlARNToStandardsSubscriptionARN(t *testing.T) {
 		{
 			TestName:      "invalid ARN resource parts",
 			InputARN:      "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark", //lintignore:AWSAT003,AWSAT005
-			ExpectedError: regexp.MustCompile(`expected at least 3 resource parts`),
+			ExpectedError: regexp.MustCompile(`expected standard`),
 		},
 		{
 			TestName:    "valid ARN",
@@ -270,7 +270,7 @@ func sweepServices(region string) error {
 			d.SetId(aws.StringValue(service.Id))
 			d.Set("force_destroy", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_service_discovery_service", r, d, client))
 		}
 
 		return !lastPage
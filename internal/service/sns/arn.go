@@ -0,0 +1,23 @@
+package sns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+// TopicNameFromARN returns the name of the SNS topic with the specified ARN.
+func TopicNameFromARN(topicARN string) (string, error) {
+	parsedARN, err := arn.Parse(topicARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", topicARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, sns.ServiceName; actual != expected {
+		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, topicARN, actual)
+	}
+
+	return parsedARN.Resource, nil
+}
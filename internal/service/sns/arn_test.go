@@ -0,0 +1,60 @@
+package sns_test
+
+import (
+	"regexp"
+	"testing"
+
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+)
+
+func TestTopicNameFromARN(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError *regexp.Regexp
+		ExpectedName  string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "unparsable ARN",
+			InputARN:      "test",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN service",
+			InputARN:      "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: regexp.MustCompile(`expected service sns`),
+		},
+		{
+			TestName:     "valid ARN",
+			InputARN:     "arn:aws:sns:us-west-2:123456789012:test.fifo", //lintignore:AWSAT003,AWSAT005
+			ExpectedName: "test.fifo",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfsns.TopicNameFromARN(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedName {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedName)
+			}
+		})
+	}
+}
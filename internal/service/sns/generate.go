@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run -tags generate ../../generate/listpages/main.go -DataSource=Topics "-DataSourceDescription=SNS Topic" -DataSourceListOp=ListTopics -DataSourceItems=Topics -DataSourceIDField=TopicArn -DataSourceARNField=TopicArn "-DataSourceNameField=TopicNameFromARN(TopicArn)" -DataSourceListTagsIDField=TopicArn
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package sns
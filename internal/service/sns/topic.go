@@ -190,7 +190,7 @@ var (
 		"sqs_failure_feedback_role_arn":         TopicAttributeNameSQSFailureFeedbackRoleArn,
 		"sqs_success_feedback_role_arn":         TopicAttributeNameSQSSuccessFeedbackRoleArn,
 		"sqs_success_feedback_sample_rate":      TopicAttributeNameSQSSuccessFeedbackSampleRate,
-	}, topicSchema).WithIAMPolicyAttribute("policy")
+	}, topicSchema).WithIAMPolicyAttribute("policy").WithJSONAttribute("delivery_policy")
 )
 
 func ResourceTopic() *schema.Resource {
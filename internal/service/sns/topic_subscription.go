@@ -113,7 +113,13 @@ var (
 		"redrive_policy":                 SubscriptionAttributeNameRedrivePolicy,
 		"subscription_role_arn":          SubscriptionAttributeNameSubscriptionRoleArn,
 		"topic_arn":                      SubscriptionAttributeNameTopicArn,
-	}, subscriptionSchema)
+	}, subscriptionSchema).
+		WithJSONAttribute("filter_policy").
+		WithJSONAttribute("redrive_policy").
+		// Endpoint, Protocol and TopicArn are not passed in Attributes.
+		WithReadOnlyAttribute("endpoint").
+		WithReadOnlyAttribute("protocol").
+		WithReadOnlyAttribute("topic_arn")
 )
 
 func ResourceTopicSubscription() *schema.Resource {
@@ -139,11 +145,6 @@ func resourceTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	// Endpoint, Protocol and TopicArn are not passed in Attributes.
-	delete(attributes, SubscriptionAttributeNameEndpoint)
-	delete(attributes, SubscriptionAttributeNameProtocol)
-	delete(attributes, SubscriptionAttributeNameTopicArn)
-
 	input := &sns.SubscribeInput{
 		Attributes:            aws.StringMap(attributes),
 		Endpoint:              aws.String(d.Get("endpoint").(string)),
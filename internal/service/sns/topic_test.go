@@ -81,6 +81,46 @@ func TestAccSNSTopic_basic(t *testing.T) {
 	})
 }
 
+func TestSNSTopic_fakeAWS(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandString(10)
+
+	acctest.FakeAWSTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sns", fmt.Sprintf("terraform-test-topic-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("terraform-test-topic-%s", rName)),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_name(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
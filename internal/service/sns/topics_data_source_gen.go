@@ -0,0 +1,109 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=Topics -DataSourceDescription=SNS Topic -DataSourceListOp=ListTopics -DataSourceItems=Topics -DataSourceIDField=TopicArn -DataSourceARNField=TopicArn -DataSourceNameField=TopicNameFromARN(TopicArn) -DataSourceListTagsIDField=TopicArn"; DO NOT EDIT.
+
+package sns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &sns.ListTopicsInput{}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var arns, ids, names []string
+	var itemErr error
+
+	err := conn.ListTopicsPages(input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Topics {
+			if v == nil {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, aws.StringValue(v.TopicArn))
+
+				if err != nil {
+					itemErr = fmt.Errorf("error listing tags for SNS Topic (%s): %w", aws.StringValue(v.TopicArn), err)
+
+					return false
+				}
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			id := aws.StringValue(v.TopicArn)
+
+			name, err := TopicNameFromARN(aws.StringValue(v.TopicArn))
+
+			if err != nil {
+				itemErr = fmt.Errorf("error reading SNS Topic (%s) name: %w", aws.StringValue(v.TopicArn), err)
+
+				return false
+			}
+
+			arn := aws.StringValue(v.TopicArn)
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SNS Topics: %w", err)
+	}
+
+	if itemErr != nil {
+		return itemErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
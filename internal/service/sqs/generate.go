@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListQueueTags -ListTagsInIDElem=QueueUrl -ServiceTagsMap -TagOp=TagQueue -TagInIDElem=QueueUrl -UntagOp=UntagQueue -UpdateTags
+//go:generate go run -tags generate ../../generate/listpages/main.go -DataSource=Queues "-DataSourceDescription=SQS Queue" -DataSourceListOp=ListQueues -DataSourceItems=QueueUrls -DataSourceIDField=. "-DataSourceNameField=QueueNameFromURL(.)" -DataSourceARNService=sqs -DataSourceArgs=queue_name_prefix:QueueNamePrefix -DataSourceListTagsIDField=.
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package sqs
@@ -163,7 +163,12 @@ var (
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
 		"sqs_managed_sse_enabled":           sqs.QueueAttributeNameSqsManagedSseEnabled,
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
-	}, queueSchema).WithIAMPolicyAttribute("policy")
+	}, queueSchema).
+		WithIAMPolicyAttribute("policy").
+		WithJSONAttribute("redrive_allow_policy").
+		WithJSONAttribute("redrive_policy").
+		// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
+		WithDefaultValue("kms_data_key_reuse_period_seconds", DefaultQueueKMSDataKeyReusePeriodSeconds)
 )
 
 func ResourceQueue() *schema.Resource {
@@ -291,11 +296,6 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// Backwards compatibility: https://github.com/hashicorp/terraform-provider-aws/issues/19786.
-	if d.Get("kms_data_key_reuse_period_seconds").(int) == 0 {
-		d.Set("kms_data_key_reuse_period_seconds", DefaultQueueKMSDataKeyReusePeriodSeconds)
-	}
-
 	d.Set("name", name)
 	if d.Get("fifo_queue").(bool) {
 		d.Set("name_prefix", create.NamePrefixFromNameWithSuffix(name, FIFOQueueNameSuffix))
@@ -2,10 +2,12 @@ package sqs_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -73,6 +75,87 @@ func TestAccSQSQueue_basic(t *testing.T) {
 	})
 }
 
+// TestAccSQSQueue_findQueueAttributesByURL calls the SQS API directly rather than through Terraform,
+// so that its recorded AWS API interactions can be replayed without the Terraform CLI.
+func TestAccSQSQueue_findQueueAttributesByURL(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	acctest.PreCheck(t)
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(rName),
+	})
+
+	if err != nil {
+		t.Fatalf("creating SQS Queue (%s): %s", rName, err)
+	}
+
+	url := aws.StringValue(output.QueueUrl)
+
+	attributes, err := tfsqs.FindQueueAttributesByURL(conn, url)
+
+	if err != nil {
+		t.Fatalf("reading SQS Queue (%s): %s", url, err)
+	}
+
+	if got, expected := attributes[sqs.QueueAttributeNameDelaySeconds], strconv.Itoa(tfsqs.DefaultQueueDelaySeconds); got != expected {
+		t.Errorf("got DelaySeconds %s, expected %s", got, expected)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: aws.String(url)}); err != nil {
+		t.Fatalf("deleting SQS Queue (%s): %s", url, err)
+	}
+
+	if _, err := tfsqs.FindQueueAttributesByURL(conn, url); !tfresource.NotFound(err) {
+		t.Errorf("expected SQS Queue (%s) to be not found, got: %v", url, err)
+	}
+}
+
+func TestSQSQueue_fakeAWS(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	acctest.FakeAWSTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNameConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sqs", rName),
+					resource.TestCheckResourceAttr(resourceName, "delay_seconds", strconv.Itoa(tfsqs.DefaultQueueDelaySeconds)),
+					resource.TestCheckResourceAttr(resourceName, "fifo_queue", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "url", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", strconv.Itoa(tfsqs.DefaultQueueVisibilityTimeout)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_disappears(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
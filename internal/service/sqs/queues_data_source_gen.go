@@ -0,0 +1,124 @@
+// Code generated by "internal/generate/listpages/main.go -DataSource=Queues -DataSourceDescription=SQS Queue -DataSourceListOp=ListQueues -DataSourceItems=QueueUrls -DataSourceIDField=. -DataSourceNameField=QueueNameFromURL(.) -DataSourceARNService=sqs -DataSourceArgs=queue_name_prefix:QueueNamePrefix -DataSourceListTagsIDField=."; DO NOT EDIT.
+
+package sqs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceQueues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceQueuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"queue_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceQueuesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &sqs.ListQueuesInput{}
+
+	if v, ok := d.GetOk("queue_name_prefix"); ok {
+		input.QueueNamePrefix = aws.String(v.(string))
+	}
+
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var arns, ids, names []string
+	var itemErr error
+
+	err := conn.ListQueuesPages(input, func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.QueueUrls {
+			if v == nil {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				tags, err := ListTags(conn, aws.StringValue(v))
+
+				if err != nil {
+					itemErr = fmt.Errorf("error listing tags for SQS Queue (%s): %w", aws.StringValue(v), err)
+
+					return false
+				}
+
+				if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+					continue
+				}
+			}
+
+			id := aws.StringValue(v)
+
+			name, err := QueueNameFromURL(aws.StringValue(v))
+
+			if err != nil {
+				itemErr = fmt.Errorf("error reading SQS Queue (%s) name: %w", aws.StringValue(v), err)
+
+				return false
+			}
+
+			arn := arn.ARN{
+				Partition: meta.(*conns.AWSClient).Partition,
+				Service:   "sqs",
+				Region:    meta.(*conns.AWSClient).Region,
+				AccountID: meta.(*conns.AWSClient).AccountID,
+				Resource:  name,
+			}.String()
+
+			arns = append(arns, arn)
+			ids = append(ids, id)
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queues: %w", err)
+	}
+
+	if itemErr != nil {
+		return itemErr
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return nil
+}
@@ -1,44 +1,37 @@
 package transfer
 
 import (
-	"fmt"
-	"strings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfid"
 )
 
-const userResourceIDSeparator = "/"
+var userResourceIDFormat = tfid.NewFormat("/", "SERVERID", "USERNAME")
 
 func UserCreateResourceID(serverID, userName string) string {
-	parts := []string{serverID, userName}
-	id := strings.Join(parts, userResourceIDSeparator)
-
-	return id
+	return userResourceIDFormat.ID(serverID, userName)
 }
 
 func UserParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, userResourceIDSeparator)
+	parts, err := userResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVERID%[2]sUSERNAME", id, userResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
 
-const accessResourceIDSeparator = "/"
+var accessResourceIDFormat = tfid.NewFormat("/", "SERVERID", "EXTERNALID")
 
 func AccessCreateResourceID(serverID, externalID string) string {
-	parts := []string{serverID, externalID}
-	id := strings.Join(parts, accessResourceIDSeparator)
-
-	return id
+	return accessResourceIDFormat.ID(serverID, externalID)
 }
 
 func AccessParseResourceID(id string) (string, string, error) {
-	parts := strings.Split(id, accessResourceIDSeparator)
+	parts, err := accessResourceIDFormat.Parse(id)
 
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		return parts[0], parts[1], nil
+	if err != nil {
+		return "", "", err
 	}
 
-	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVERID%[2]sEXTERNALID", id, accessResourceIDSeparator)
+	return parts[0], parts[1], nil
 }
@@ -42,7 +42,7 @@ func sweepServers(region string) error {
 			d.Set("force_destroy", true) // In lieu of an aws_transfer_user sweeper.
 			d.Set("identity_provider_type", server.IdentityProviderType)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_transfer_server", r, d, client))
 		}
 
 		return !lastPage
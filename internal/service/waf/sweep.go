@@ -174,7 +174,7 @@ func sweepByteMatchSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_byte_match_set", r, d, client))
 
 				return nil
 			})
@@ -248,7 +248,7 @@ func sweepGeoMatchSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_geo_match_set", r, d, client))
 
 				return nil
 			})
@@ -322,7 +322,7 @@ func sweepIPSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_ipset", r, d, client))
 
 				return nil
 			})
@@ -396,7 +396,7 @@ func sweepRateBasedRules(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_rate_based_rule", r, d, client))
 
 				return nil
 			})
@@ -470,7 +470,7 @@ func sweepRegexMatchSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_regex_match_set", r, d, client))
 
 				return nil
 			})
@@ -544,7 +544,7 @@ func sweepRegexPatternSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_regex_pattern_set", r, d, client))
 
 				return nil
 			})
@@ -618,7 +618,7 @@ func sweepRuleGroups(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_rule_group", r, d, client))
 
 				return nil
 			})
@@ -696,7 +696,7 @@ func sweepRules(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_rule", r, d, client))
 
 				return nil
 			})
@@ -770,7 +770,7 @@ func sweepSizeConstraintSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_size_constraint_set", r, d, client))
 
 				return nil
 			})
@@ -844,7 +844,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_sql_injection_match_set", r, d, client))
 
 				return nil
 			})
@@ -922,7 +922,7 @@ func sweepWebACLs(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_web_acl", r, d, client))
 
 				return nil
 			})
@@ -996,7 +996,7 @@ func sweepXSSMatchSet(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_waf_xss_match_set", r, d, client))
 
 				return nil
 			})
@@ -249,7 +249,7 @@ func sweepWebACLs(region string) error {
 			d.Set("name", name)
 			d.Set("scope", input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_wafv2_web_acl", r, d, client))
 		}
 
 		return !lastPage
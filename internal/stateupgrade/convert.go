@@ -0,0 +1,170 @@
+package stateupgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Converter converts a non-null attribute value from its type in a resource's previous schema
+// to its type in the next schema.
+type Converter func(v interface{}) (interface{}, error)
+
+// ToString converts a number or bool value to a string.
+func ToString(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to string", v)
+	}
+}
+
+// ToInt converts a string or whole number value to an int.
+// Empty strings are converted to null.
+func ToInt(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("cannot convert %v to int", v)
+		}
+
+		return int(v), nil
+	case json.Number:
+		i, err := v.Int64()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return int(i), nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+
+		return strconv.Atoi(v)
+	default:
+		return nil, fmt.Errorf("cannot convert %T to int", v)
+	}
+}
+
+// ToFloat converts a string or number value to a float64.
+// Empty strings are converted to null.
+func ToFloat(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+
+		return strconv.ParseFloat(v, 64)
+	default:
+		return nil, fmt.Errorf("cannot convert %T to float", v)
+	}
+}
+
+// ToBool converts a string value to a bool.
+// Empty strings are converted to null.
+func ToBool(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+
+		return strconv.ParseBool(v)
+	default:
+		return nil, fmt.Errorf("cannot convert %T to bool", v)
+	}
+}
+
+// ToList converts a value to a single-element list, e.g. a string attribute to a list or set of strings,
+// or an object attribute to a block.
+func ToList(v interface{}) (interface{}, error) {
+	return []interface{}{v}, nil
+}
+
+// FromList converts a list of at most one element to that element, or to null if the list is empty.
+func FromList(v interface{}) (interface{}, error) {
+	elements, ok := v.([]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %T from list", v)
+	}
+
+	switch len(elements) {
+	case 0:
+		return nil, nil
+	case 1:
+		return elements[0], nil
+	default:
+		return nil, fmt.Errorf("cannot convert list of %d elements to a single value", len(elements))
+	}
+}
+
+// Elements returns a Converter that converts each element of a list, set or map value.
+func Elements(convert Converter) Converter {
+	return func(v interface{}) (interface{}, error) {
+		switch v := v.(type) {
+		case []interface{}:
+			elements := make([]interface{}, len(v))
+
+			for i, e := range v {
+				if e == nil {
+					continue
+				}
+
+				e, err := convert(e)
+
+				if err != nil {
+					return nil, err
+				}
+
+				elements[i] = e
+			}
+
+			return elements, nil
+		case map[string]interface{}:
+			elements := make(map[string]interface{}, len(v))
+
+			for k, e := range v {
+				if e == nil {
+					elements[k] = nil
+					continue
+				}
+
+				e, err := convert(e)
+
+				if err != nil {
+					return nil, err
+				}
+
+				elements[k] = e
+			}
+
+			return elements, nil
+		default:
+			return nil, fmt.Errorf("cannot convert elements of %T", v)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package stateupgrade_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
+)
+
+func TestConverters(t *testing.T) {
+	testCases := []struct {
+		name      string
+		convert   stateupgrade.Converter
+		value     interface{}
+		expected  interface{}
+		expectErr bool
+	}{
+		{name: "ToString string", convert: stateupgrade.ToString, value: "test", expected: "test"},
+		{name: "ToString bool", convert: stateupgrade.ToString, value: true, expected: "true"},
+		{name: "ToString int", convert: stateupgrade.ToString, value: 42, expected: "42"},
+		{name: "ToString float", convert: stateupgrade.ToString, value: 1.5, expected: "1.5"},
+		{name: "ToString whole float", convert: stateupgrade.ToString, value: float64(300), expected: "300"},
+		{name: "ToString json.Number", convert: stateupgrade.ToString, value: json.Number("9007199254740993"), expected: "9007199254740993"},
+		{name: "ToString list", convert: stateupgrade.ToString, value: []interface{}{}, expectErr: true},
+		{name: "ToInt string", convert: stateupgrade.ToInt, value: "42", expected: 42},
+		{name: "ToInt empty string", convert: stateupgrade.ToInt, value: "", expected: nil},
+		{name: "ToInt invalid string", convert: stateupgrade.ToInt, value: "forty-two", expectErr: true},
+		{name: "ToInt float", convert: stateupgrade.ToInt, value: float64(42), expected: 42},
+		{name: "ToInt fractional float", convert: stateupgrade.ToInt, value: 4.2, expectErr: true},
+		{name: "ToInt json.Number", convert: stateupgrade.ToInt, value: json.Number("42"), expected: 42},
+		{name: "ToInt bool", convert: stateupgrade.ToInt, value: true, expectErr: true},
+		{name: "ToFloat string", convert: stateupgrade.ToFloat, value: "1.5", expected: 1.5},
+		{name: "ToFloat empty string", convert: stateupgrade.ToFloat, value: "", expected: nil},
+		{name: "ToFloat int", convert: stateupgrade.ToFloat, value: 2, expected: float64(2)},
+		{name: "ToBool string", convert: stateupgrade.ToBool, value: "true", expected: true},
+		{name: "ToBool empty string", convert: stateupgrade.ToBool, value: "", expected: nil},
+		{name: "ToBool invalid string", convert: stateupgrade.ToBool, value: "yes", expectErr: true},
+		{name: "ToBool int", convert: stateupgrade.ToBool, value: 1, expectErr: true},
+		{name: "ToList", convert: stateupgrade.ToList, value: "test", expected: []interface{}{"test"}},
+		{name: "FromList", convert: stateupgrade.FromList, value: []interface{}{"test"}, expected: "test"},
+		{name: "FromList empty", convert: stateupgrade.FromList, value: []interface{}{}, expected: nil},
+		{name: "FromList multiple elements", convert: stateupgrade.FromList, value: []interface{}{"a", "b"}, expectErr: true},
+		{name: "FromList string", convert: stateupgrade.FromList, value: "test", expectErr: true},
+		{
+			name:     "Elements list",
+			convert:  stateupgrade.Elements(stateupgrade.ToString),
+			value:    []interface{}{80, nil, 443},
+			expected: []interface{}{"80", nil, "443"},
+		},
+		{
+			name:     "Elements map",
+			convert:  stateupgrade.Elements(stateupgrade.ToInt),
+			value:    map[string]interface{}{"a": "1", "b": nil},
+			expected: map[string]interface{}{"a": 1, "b": nil},
+		},
+		{
+			name:      "Elements error",
+			convert:   stateupgrade.Elements(stateupgrade.ToInt),
+			value:     []interface{}{"one"},
+			expectErr: true,
+		},
+		{
+			name:      "Elements string",
+			convert:   stateupgrade.Elements(stateupgrade.ToInt),
+			value:     "1",
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := testCase.convert(testCase.value)
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got %#v", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
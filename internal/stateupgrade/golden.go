@@ -0,0 +1,221 @@
+package stateupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const goldenSuffix = ".golden.json"
+
+// instanceState is a resource instance object in a Terraform state file.
+type instanceState struct {
+	SchemaVersion int             `json:"schema_version"`
+	Attributes    json.RawMessage `json:"attributes"`
+}
+
+// CheckGolden tests a resource's StateUpgraders against recorded legacy states.
+//
+// Each <name>.json file in dir is a resource instance object copied from a Terraform state file,
+// with at least its "schema_version" and "attributes".
+// Its attributes are upgraded to the resource's current SchemaVersion the same way Terraform does,
+// attributes that are no longer in the resource's schema are dropped, and the result must equal
+// the "attributes" of the matching <name>.golden.json file.
+//
+// Upgraders are called with nil meta, so upgraders that make AWS API calls can't be tested this way.
+func CheckGolden(t *testing.T, resource *schema.Resource, dir string) {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var n int
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, goldenSuffix) {
+			continue
+		}
+
+		n++
+		path := path
+
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".json"), func(t *testing.T) {
+			checkGolden(t, resource, path, strings.TrimSuffix(path, ".json")+goldenSuffix)
+		})
+	}
+
+	if n == 0 {
+		t.Fatalf("no recorded states in %s", dir)
+	}
+}
+
+func checkGolden(t *testing.T, resource *schema.Resource, path, goldenPath string) {
+	legacy, err := readInstanceState(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden, err := readInstanceState(goldenPath)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if golden.SchemaVersion != resource.SchemaVersion {
+		t.Fatalf("%s: got schema_version %d, want %d", goldenPath, golden.SchemaVersion, resource.SchemaVersion)
+	}
+
+	var rawState map[string]interface{}
+
+	if err := decodeJSON(legacy.Attributes, resource.UseJSONNumber, &rawState); err != nil {
+		t.Fatalf("%s: decoding attributes: %s", path, err)
+	}
+
+	rawState, err = upgrade(context.Background(), resource, legacy.SchemaVersion, rawState)
+
+	if err != nil {
+		t.Fatalf("%s: %s", path, err)
+	}
+
+	got, err := normalize(rawState)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want interface{}
+
+	if err := json.Unmarshal(golden.Attributes, &want); err != nil {
+		t.Fatalf("%s: decoding attributes: %s", goldenPath, err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.MarshalIndent(got, "", "  ")
+		wantJSON, _ := json.MarshalIndent(want, "", "  ")
+
+		t.Errorf("upgraded state doesn't match %s\n\ngot:\n\n%s\n\nwant:\n\n%s", goldenPath, gotJSON, wantJSON)
+	}
+}
+
+// upgrade calls resource's StateUpgraders, in order, from version to the resource's current SchemaVersion,
+// removes attributes that are no longer in the resource's schema and checks that the result conforms to the schema.
+func upgrade(ctx context.Context, resource *schema.Resource, version int, rawState map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+
+	for _, upgrader := range resource.StateUpgraders {
+		if upgrader.Version != version {
+			continue
+		}
+
+		rawState, err = upgrader.Upgrade(ctx, rawState, nil)
+
+		if err != nil {
+			return nil, fmt.Errorf("upgrading state from version %d: %w", version, err)
+		}
+
+		version++
+	}
+
+	if version != resource.SchemaVersion {
+		return nil, fmt.Errorf("no state upgrader for version %d", version)
+	}
+
+	removeAttributes(rawState, resource)
+
+	if _, err := schema.JSONMapToStateValue(rawState, resource.CoreConfigSchema()); err != nil {
+		return nil, fmt.Errorf("upgraded state doesn't conform to schema: %w", err)
+	}
+
+	return rawState, nil
+}
+
+// removeAttributes removes attributes that aren't in resource's schema from rawState,
+// as Terraform does after upgrading state.
+func removeAttributes(rawState map[string]interface{}, resource *schema.Resource) {
+	for k, v := range rawState {
+		s, ok := resource.Schema[k]
+
+		if !ok {
+			if k != "id" && (k != schema.TimeoutsConfigKey || resource.Timeouts == nil) {
+				delete(rawState, k)
+			}
+
+			continue
+		}
+
+		elem, ok := s.Elem.(*schema.Resource)
+
+		if !ok {
+			continue
+		}
+
+		if elements, ok := v.([]interface{}); ok {
+			for _, e := range elements {
+				if e, ok := e.(map[string]interface{}); ok {
+					removeAttributes(e, elem)
+				}
+			}
+		}
+	}
+}
+
+func readInstanceState(path string) (*instanceState, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var is instanceState
+
+	if err := json.Unmarshal(b, &is); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	if len(is.Attributes) == 0 {
+		return nil, fmt.Errorf("%s: no attributes", path)
+	}
+
+	return &is, nil
+}
+
+func decodeJSON(b []byte, useJSONNumber bool, v interface{}) error {
+	if !useJSONNumber {
+		return json.Unmarshal(b, v)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
+
+// normalize returns v as decoded from its JSON encoding, so that values of different Go types
+// with the same JSON representation compare equal.
+func normalize(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
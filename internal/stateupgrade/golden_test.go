@@ -0,0 +1,65 @@
+package stateupgrade_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
+)
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			stateupgrade.Upgrader(0, testResourceV0(),
+				stateupgrade.ChangeType("port", stateupgrade.ToInt),
+				stateupgrade.ChangeType("security_group_id", stateupgrade.ToList),
+				stateupgrade.Rename("security_group_id", "security_group_ids"),
+				stateupgrade.Nest("network", "security_group_ids", "subnet_id"),
+				stateupgrade.Remove("legacy"),
+			),
+			stateupgrade.Upgrader(1, testResourceV1(),
+				stateupgrade.Rename("name", "display_name"),
+				stateupgrade.Default("protocol", "tcp"),
+			),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func TestCheckGolden(t *testing.T) {
+	stateupgrade.CheckGolden(t, testResource(), filepath.Join("testdata", "state"))
+}
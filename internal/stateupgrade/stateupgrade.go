@@ -0,0 +1,229 @@
+// Package stateupgrade declares resource schema version migrations as a sequence of steps,
+// such as attribute renames, type changes, block restructuring and default fills,
+// that are applied to a resource's raw JSON state.
+//
+// Attributes are addressed by dot-separated paths, e.g. "ecs_target.network_configuration.subnets".
+// Nested blocks (lists or sets of objects) are traversed element by element, so a step applies to
+// the attribute in every element of the block.
+package stateupgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Step is a single change applied to a resource's raw state.
+type Step func(rawState map[string]interface{}) error
+
+// Upgrader returns a state upgrader from version to version + 1 of a resource's schema.
+// resource is the resource's schema at version, typically returned by a resourceXxxV<version> function.
+func Upgrader(version int, resource *schema.Resource, steps ...Step) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Type: resource.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			return Apply(rawState, steps...)
+		},
+		Version: version,
+	}
+}
+
+// Apply applies steps, in order, to rawState and returns the upgraded state.
+func Apply(rawState map[string]interface{}, steps ...Step) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	for _, step := range steps {
+		if err := step(rawState); err != nil {
+			return nil, err
+		}
+	}
+
+	return rawState, nil
+}
+
+// Rename renames the attribute or block at path to name.
+// The renamed attribute stays in the same block.
+func Rename(path, name string) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			if v, ok := object[key]; ok {
+				delete(object, key)
+				object[name] = v
+			}
+		}
+
+		return nil
+	}
+}
+
+// Remove removes the attribute or block at path.
+func Remove(path string) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			delete(object, key)
+		}
+
+		return nil
+	}
+}
+
+// Default sets the attribute at path to value if it's missing or null.
+func Default(path string, value interface{}) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			if object[key] == nil {
+				object[key] = value
+			}
+		}
+
+		return nil
+	}
+}
+
+// ChangeType converts the value of the attribute at path.
+// Null values aren't converted.
+func ChangeType(path string, convert Converter) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			v, ok := object[key]
+
+			if !ok || v == nil {
+				continue
+			}
+
+			v, err := convert(v)
+
+			if err != nil {
+				return fmt.Errorf("converting %s: %w", path, err)
+			}
+
+			object[key] = v
+		}
+
+		return nil
+	}
+}
+
+// Nest moves the attributes named attrs into a new single-element block at path.
+// The attributes are moved from the block containing the new block.
+// If none of the attributes are set, the new block is empty.
+func Nest(path string, attrs ...string) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			nested := map[string]interface{}{}
+			empty := true
+
+			for _, attr := range attrs {
+				v, ok := object[attr]
+
+				if !ok {
+					continue
+				}
+
+				delete(object, attr)
+				nested[attr] = v
+
+				if v != nil {
+					empty = false
+				}
+			}
+
+			if empty {
+				object[key] = []interface{}{}
+			} else {
+				object[key] = []interface{}{nested}
+			}
+		}
+
+		return nil
+	}
+}
+
+// Unnest moves the attributes of the single-element block at path into the block containing it
+// and removes the block.
+func Unnest(path string) Step {
+	return func(rawState map[string]interface{}) error {
+		objects, key := parents(rawState, path)
+
+		for _, object := range objects {
+			v, ok := object[key]
+
+			if !ok {
+				continue
+			}
+
+			delete(object, key)
+
+			if v == nil {
+				continue
+			}
+
+			elements, ok := v.([]interface{})
+
+			if !ok {
+				return fmt.Errorf("unnesting %s: expected block, got %T", path, v)
+			}
+
+			switch len(elements) {
+			case 0:
+				continue
+			case 1:
+			default:
+				return fmt.Errorf("unnesting %s: expected at most 1 element, got %d", path, len(elements))
+			}
+
+			nested, ok := elements[0].(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("unnesting %s: expected block, got %T", path, elements[0])
+			}
+
+			for k, v := range nested {
+				object[k] = v
+			}
+		}
+
+		return nil
+	}
+}
+
+// parents returns the objects containing the attribute at path and the attribute's name.
+func parents(rawState map[string]interface{}, path string) ([]map[string]interface{}, string) {
+	keys := strings.Split(path, ".")
+	objects := []map[string]interface{}{rawState}
+
+	for _, key := range keys[:len(keys)-1] {
+		var children []map[string]interface{}
+
+		for _, object := range objects {
+			switch v := object[key].(type) {
+			case map[string]interface{}:
+				children = append(children, v)
+			case []interface{}:
+				for _, v := range v {
+					if v, ok := v.(map[string]interface{}); ok {
+						children = append(children, v)
+					}
+				}
+			}
+		}
+
+		objects = children
+	}
+
+	return objects, keys[len(keys)-1]
+}
@@ -0,0 +1,316 @@
+package stateupgrade_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/stateupgrade"
+)
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rawState  map[string]interface{}
+		steps     []stateupgrade.Step
+		expected  map[string]interface{}
+		expectErr bool
+	}{
+		{
+			name:     "nil state",
+			steps:    []stateupgrade.Step{stateupgrade.Default("enabled", true)},
+			expected: map[string]interface{}{"enabled": true},
+		},
+		{
+			name: "rename",
+			rawState: map[string]interface{}{
+				"name": "test",
+			},
+			steps: []stateupgrade.Step{stateupgrade.Rename("name", "display_name")},
+			expected: map[string]interface{}{
+				"display_name": "test",
+			},
+		},
+		{
+			name: "rename missing",
+			rawState: map[string]interface{}{
+				"id": "test",
+			},
+			steps: []stateupgrade.Step{stateupgrade.Rename("name", "display_name")},
+			expected: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		{
+			name: "rename in block",
+			rawState: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"prefix": "a/"},
+					map[string]interface{}{"prefix": "b/"},
+				},
+			},
+			steps: []stateupgrade.Step{stateupgrade.Rename("rule.prefix", "key_prefix")},
+			expected: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"key_prefix": "a/"},
+					map[string]interface{}{"key_prefix": "b/"},
+				},
+			},
+		},
+		{
+			name: "remove",
+			rawState: map[string]interface{}{
+				"id":     "test",
+				"legacy": "value",
+			},
+			steps: []stateupgrade.Step{stateupgrade.Remove("legacy")},
+			expected: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		{
+			name: "default",
+			rawState: map[string]interface{}{
+				"missing": nil,
+				"set":     "value",
+			},
+			steps: []stateupgrade.Step{
+				stateupgrade.Default("absent", "default"),
+				stateupgrade.Default("missing", "default"),
+				stateupgrade.Default("set", "default"),
+			},
+			expected: map[string]interface{}{
+				"absent":  "default",
+				"missing": "default",
+				"set":     "value",
+			},
+		},
+		{
+			name: "default in empty block",
+			rawState: map[string]interface{}{
+				"rule": []interface{}{},
+			},
+			steps: []stateupgrade.Step{stateupgrade.Default("rule.enabled", true)},
+			expected: map[string]interface{}{
+				"rule": []interface{}{},
+			},
+		},
+		{
+			name: "change type",
+			rawState: map[string]interface{}{
+				"null": nil,
+				"port": "443",
+			},
+			steps: []stateupgrade.Step{
+				stateupgrade.ChangeType("absent", stateupgrade.ToInt),
+				stateupgrade.ChangeType("null", stateupgrade.ToInt),
+				stateupgrade.ChangeType("port", stateupgrade.ToInt),
+			},
+			expected: map[string]interface{}{
+				"null": nil,
+				"port": 443,
+			},
+		},
+		{
+			name: "change type error",
+			rawState: map[string]interface{}{
+				"port": "https",
+			},
+			steps:     []stateupgrade.Step{stateupgrade.ChangeType("port", stateupgrade.ToInt)},
+			expectErr: true,
+		},
+		{
+			name: "nest",
+			rawState: map[string]interface{}{
+				"id":        "test",
+				"subnet_id": "subnet-12345678",
+				"vpc_id":    "vpc-12345678",
+			},
+			steps: []stateupgrade.Step{stateupgrade.Nest("network", "subnet_id", "vpc_id")},
+			expected: map[string]interface{}{
+				"id": "test",
+				"network": []interface{}{
+					map[string]interface{}{
+						"subnet_id": "subnet-12345678",
+						"vpc_id":    "vpc-12345678",
+					},
+				},
+			},
+		},
+		{
+			name: "nest null",
+			rawState: map[string]interface{}{
+				"id":        "test",
+				"subnet_id": nil,
+			},
+			steps: []stateupgrade.Step{stateupgrade.Nest("network", "subnet_id", "vpc_id")},
+			expected: map[string]interface{}{
+				"id":      "test",
+				"network": []interface{}{},
+			},
+		},
+		{
+			name: "unnest",
+			rawState: map[string]interface{}{
+				"id": "test",
+				"network": []interface{}{
+					map[string]interface{}{
+						"subnet_id": "subnet-12345678",
+					},
+				},
+			},
+			steps: []stateupgrade.Step{stateupgrade.Unnest("network")},
+			expected: map[string]interface{}{
+				"id":        "test",
+				"subnet_id": "subnet-12345678",
+			},
+		},
+		{
+			name: "unnest empty",
+			rawState: map[string]interface{}{
+				"id":      "test",
+				"network": []interface{}{},
+			},
+			steps: []stateupgrade.Step{stateupgrade.Unnest("network")},
+			expected: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		{
+			name: "unnest multiple elements",
+			rawState: map[string]interface{}{
+				"network": []interface{}{
+					map[string]interface{}{"subnet_id": "subnet-12345678"},
+					map[string]interface{}{"subnet_id": "subnet-87654321"},
+				},
+			},
+			steps:     []stateupgrade.Step{stateupgrade.Unnest("network")},
+			expectErr: true,
+		},
+		{
+			name: "steps in order",
+			rawState: map[string]interface{}{
+				"security_group_id": "sg-12345678",
+			},
+			steps: []stateupgrade.Step{
+				stateupgrade.ChangeType("security_group_id", stateupgrade.ToList),
+				stateupgrade.Rename("security_group_id", "security_group_ids"),
+				stateupgrade.Nest("network", "security_group_ids"),
+			},
+			expected: map[string]interface{}{
+				"network": []interface{}{
+					map[string]interface{}{
+						"security_group_ids": []interface{}{"sg-12345678"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := stateupgrade.Apply(testCase.rawState, testCase.steps...)
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestUpgrader(t *testing.T) {
+	upgrader := stateupgrade.Upgrader(1, testResourceV1(), stateupgrade.Rename("name", "display_name"))
+
+	if got, want := upgrader.Version, 1; got != want {
+		t.Errorf("got version %d, want %d", got, want)
+	}
+
+	if got, want := upgrader.Type, testResourceV1().CoreConfigSchema().ImpliedType(); !got.Equals(want) {
+		t.Errorf("got type %s, want %s", got.FriendlyName(), want.FriendlyName())
+	}
+
+	got, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"name": "test"}, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]interface{}{"display_name": "test"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, got)
+	}
+}
+
+func testResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"legacy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func testResourceV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
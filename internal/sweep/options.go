@@ -0,0 +1,186 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// EnvVarDryRun is the environment variable which, when true, lists the resources that would be deleted without deleting them.
+	EnvVarDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// EnvVarNamePrefixes is the environment variable containing a comma-separated list of name prefixes.
+	// Only resources whose ID or name begins with one of the prefixes are deleted.
+	EnvVarNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// EnvVarTags is the environment variable containing a comma-separated list of <key>=<value> tags.
+	// Only resources with all of the tags are deleted. A tag without a value matches any value.
+	EnvVarTags = "TF_AWS_SWEEP_TAGS"
+
+	// EnvVarSummaryFile is the environment variable containing the path of the JSON sweep summary file.
+	EnvVarSummaryFile = "TF_AWS_SWEEP_SUMMARY_FILE"
+)
+
+type sweepOptions struct {
+	dryRun       bool
+	namePrefixes []string
+	tags         map[string]string
+	summaryFile  string
+}
+
+func sweepOptionsFromEnv() (*sweepOptions, error) {
+	options := &sweepOptions{
+		summaryFile: os.Getenv(EnvVarSummaryFile),
+	}
+
+	if v := os.Getenv(EnvVarDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarDryRun, err)
+		}
+
+		options.dryRun = dryRun
+	}
+
+	if v := os.Getenv(EnvVarNamePrefixes); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				options.namePrefixes = append(options.namePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(EnvVarTags); v != "" {
+		options.tags = make(map[string]string)
+
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag == "" {
+				continue
+			}
+
+			key, value := tag, ""
+
+			if i := strings.Index(tag, "="); i >= 0 {
+				key, value = tag[:i], tag[i+1:]
+			}
+
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: tag (%s) must be of the form <key>=<value>", EnvVarTags, tag)
+			}
+
+			options.tags[key] = value
+		}
+	}
+
+	return options, nil
+}
+
+// matches returns whether the resource matches the name and tag filters.
+// Resources without a name or tags attribute never match the corresponding filter.
+// A name or tags set by the sweeper are used, otherwise the orchestrator reads the resource to look them up.
+func (o *sweepOptions) matches(d *schema.ResourceData) bool {
+	return o.matchesName(d) && o.matchesTags(d)
+}
+
+func (o *sweepOptions) matchesName(d *schema.ResourceData) bool {
+	if len(o.namePrefixes) == 0 {
+		return true
+	}
+
+	names := []string{d.Id()}
+
+	if v, ok := d.GetOk("name"); ok {
+		if v, ok := v.(string); ok {
+			names = append(names, v)
+		}
+	}
+
+	for _, name := range names {
+		for _, prefix := range o.namePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (o *sweepOptions) matchesTags(d *schema.ResourceData) bool {
+	if len(o.tags) == 0 {
+		return true
+	}
+
+	tags := resourceTags(d)
+
+	for key, value := range o.tags {
+		v, ok := tags[key]
+
+		if !ok {
+			return false
+		}
+
+		if value != "" && v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// needsRead returns whether the resource must be read before matching the name or tag filters.
+// Sweepers typically set only the resource's ID.
+func (o *sweepOptions) needsRead(resource *schema.Resource, d *schema.ResourceData) bool {
+	return o.needsName(resource, d) || o.needsTags(resource, d)
+}
+
+// needsName returns whether the resource's name must be read before matching the name filters.
+func (o *sweepOptions) needsName(resource *schema.Resource, d *schema.ResourceData) bool {
+	if len(o.namePrefixes) == 0 || o.matchesName(d) {
+		return false
+	}
+
+	if _, ok := d.GetOk("name"); ok {
+		return false
+	}
+
+	_, ok := resource.Schema["name"]
+
+	return ok
+}
+
+// needsTags returns whether the resource's tags must be read before matching the tag filters.
+func (o *sweepOptions) needsTags(resource *schema.Resource, d *schema.ResourceData) bool {
+	if len(o.tags) == 0 || resourceTags(d) != nil {
+		return false
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := resource.Schema[k]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceTags returns the resource's tags, or nil if neither its tags_all nor tags attribute is set.
+func resourceTags(d *schema.ResourceData) map[string]interface{} {
+	for _, k := range []string{"tags_all", "tags"} {
+		if v, ok := d.GetOk(k); ok {
+			if v, ok := v.(map[string]interface{}); ok {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
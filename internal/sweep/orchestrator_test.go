@@ -0,0 +1,316 @@
+//go:build sweep
+// +build sweep
+
+package sweep_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+type testSweeper struct {
+	mu      sync.Mutex
+	deleted []string
+	failIDs map[string]error
+	names   map[string]string
+	tags    map[string]map[string]interface{}
+}
+
+func (ts *testSweeper) resource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			name, nameOk := ts.names[d.Id()]
+			tags, tagsOk := ts.tags[d.Id()]
+
+			if !nameOk && !tagsOk {
+				d.SetId("")
+				return nil
+			}
+
+			if err := d.Set("name", name); err != nil {
+				return err
+			}
+
+			return d.Set("tags", tags)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			if err, ok := ts.failIDs[d.Id()]; ok {
+				return err
+			}
+
+			ts.mu.Lock()
+			defer ts.mu.Unlock()
+
+			ts.deleted = append(ts.deleted, d.Id())
+
+			return nil
+		},
+	}
+}
+
+func (ts *testSweeper) sweepResource(t *testing.T, region, id string, tags map[string]interface{}) *sweep.SweepResource {
+	r := ts.resource()
+	d := r.Data(nil)
+	d.SetId(id)
+
+	if err := d.Set("tags", tags); err != nil {
+		t.Fatalf("error setting tags: %s", err)
+	}
+
+	return sweep.NewSweepResource("aws_test", r, d, &conns.AWSClient{Region: region})
+}
+
+func testSweepOrchestrator(sweepResources []*sweep.SweepResource) error {
+	return sweep.SweepOrchestratorWithContext(context.Background(), sweepResources, 0, 0, 0, 10*time.Millisecond, 100*time.Millisecond)
+}
+
+func summaryIDs(entries []sweep.SweepSummaryEntry) []string {
+	ids := []string{}
+
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+
+	return ids
+}
+
+func TestSweepOrchestratorDependencies(t *testing.T) {
+	ts := &testSweeper{}
+	subnet1 := ts.sweepResource(t, "test-dependencies", "subnet-1", nil)
+	subnet2 := ts.sweepResource(t, "test-dependencies", "subnet-2", nil)
+	vpc := ts.sweepResource(t, "test-dependencies", "vpc", nil).DependsOn(subnet1, subnet2)
+
+	if err := testSweepOrchestrator([]*sweep.SweepResource{vpc, subnet1, subnet2}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(ts.deleted), 3; got != expected {
+		t.Fatalf("got %d deleted resources, expected %d", got, expected)
+	}
+
+	if got, expected := ts.deleted[2], "vpc"; got != expected {
+		t.Errorf("got %s deleted last, expected %s", got, expected)
+	}
+}
+
+func TestSweepOrchestratorDependencyFailed(t *testing.T) {
+	ts := &testSweeper{
+		failIDs: map[string]error{"subnet": errors.New("InvalidParameterValue: test")},
+	}
+	subnet := ts.sweepResource(t, "test-dependency-failed", "subnet", nil)
+	vpc := ts.sweepResource(t, "test-dependency-failed", "vpc", nil).DependsOn(subnet)
+
+	if err := testSweepOrchestrator([]*sweep.SweepResource{vpc, subnet}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(ts.deleted) != 0 {
+		t.Errorf("got deleted resources %v, expected none", ts.deleted)
+	}
+
+	summary := sweep.SweepSummary()["test-dependency-failed"]
+
+	if got, expected := summaryIDs(summary.Failed), []string{"subnet"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got failed %v, expected %v", got, expected)
+	}
+
+	if got, expected := summaryIDs(summary.Skipped), []string{"vpc"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got skipped %v, expected %v", got, expected)
+	}
+}
+
+func TestSweepOrchestratorDependencyCycle(t *testing.T) {
+	ts := &testSweeper{}
+	a := ts.sweepResource(t, "test-cycle", "a", nil)
+	b := ts.sweepResource(t, "test-cycle", "b", nil).DependsOn(a)
+	a.DependsOn(b)
+
+	if err := testSweepOrchestrator([]*sweep.SweepResource{a, b}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(ts.deleted) != 0 {
+		t.Errorf("got deleted resources %v, expected none", ts.deleted)
+	}
+}
+
+func TestSweepOrchestratorRetryable(t *testing.T) {
+	ts := &testSweeper{
+		failIDs: map[string]error{"vpc": errors.New("DependencyViolation: test")},
+	}
+	vpc := ts.sweepResource(t, "test-retryable", "vpc", nil)
+
+	err := testSweepOrchestrator([]*sweep.SweepResource{vpc})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	summary := sweep.SweepSummary()["test-retryable"]
+
+	if got, expected := summaryIDs(summary.Failed), []string{"vpc"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got failed %v, expected %v", got, expected)
+	}
+}
+
+func TestSweepOrchestratorFilters(t *testing.T) {
+	t.Setenv(sweep.EnvVarNamePrefixes, sweep.ResourcePrefix)
+	t.Setenv(sweep.EnvVarTags, "Owner=acctest,Name")
+
+	ts := &testSweeper{}
+	sweepResources := []*sweep.SweepResource{
+		ts.sweepResource(t, "test-filters", "tf-acc-test-1", map[string]interface{}{"Owner": "acctest", "Name": "test"}),
+		ts.sweepResource(t, "test-filters", "tf-acc-test-2", map[string]interface{}{"Owner": "someone"}),
+		ts.sweepResource(t, "test-filters", "production", map[string]interface{}{"Owner": "acctest", "Name": "test"}),
+	}
+
+	if err := testSweepOrchestrator(sweepResources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := ts.deleted, []string{"tf-acc-test-1"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got deleted %v, expected %v", got, expected)
+	}
+
+	if got, expected := len(sweep.SweepSummary()["test-filters"].Skipped), 2; got != expected {
+		t.Errorf("got %d skipped resources, expected %d", got, expected)
+	}
+}
+
+func TestSweepOrchestratorFiltersReadTags(t *testing.T) {
+	t.Setenv(sweep.EnvVarTags, "Owner=acctest")
+
+	ts := &testSweeper{
+		tags: map[string]map[string]interface{}{
+			"vpc-1": {"Owner": "acctest"},
+			"vpc-2": {"Owner": "someone"},
+		},
+	}
+	sweepResources := []*sweep.SweepResource{
+		ts.sweepResource(t, "test-filters-read-tags", "vpc-1", nil),
+		ts.sweepResource(t, "test-filters-read-tags", "vpc-2", nil),
+		ts.sweepResource(t, "test-filters-read-tags", "vpc-3", nil),
+	}
+
+	if err := testSweepOrchestrator(sweepResources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := ts.deleted, []string{"vpc-1"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got deleted %v, expected %v", got, expected)
+	}
+
+	reasons := make(map[string]string)
+
+	for _, entry := range sweep.SweepSummary()["test-filters-read-tags"].Skipped {
+		reasons[entry.ID] = entry.Reason
+	}
+
+	if got, expected := reasons, map[string]string{"vpc-2": "does not match sweep filters", "vpc-3": "not found"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got skipped %v, expected %v", got, expected)
+	}
+}
+
+func TestSweepOrchestratorFiltersReadName(t *testing.T) {
+	t.Setenv(sweep.EnvVarNamePrefixes, sweep.ResourcePrefix)
+
+	ts := &testSweeper{
+		names: map[string]string{
+			"vpc-1": "tf-acc-test-1",
+			"vpc-2": "production",
+		},
+	}
+	sweepResources := []*sweep.SweepResource{
+		ts.sweepResource(t, "test-filters-read-name", "vpc-1", nil),
+		ts.sweepResource(t, "test-filters-read-name", "vpc-2", nil),
+		ts.sweepResource(t, "test-filters-read-name", "tf-acc-test-3", nil),
+	}
+
+	if err := testSweepOrchestrator(sweepResources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sort.Strings(ts.deleted)
+
+	if got, expected := ts.deleted, []string{"tf-acc-test-3", "vpc-1"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got deleted %v, expected %v", got, expected)
+	}
+
+	reasons := make(map[string]string)
+
+	for _, entry := range sweep.SweepSummary()["test-filters-read-name"].Skipped {
+		reasons[entry.ID] = entry.Reason
+	}
+
+	if got, expected := reasons, map[string]string{"vpc-2": "does not match sweep filters"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got skipped %v, expected %v", got, expected)
+	}
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "summary.json")
+
+	t.Setenv(sweep.EnvVarDryRun, "true")
+	t.Setenv(sweep.EnvVarSummaryFile, filename)
+
+	ts := &testSweeper{}
+	subnet := ts.sweepResource(t, "test-dry-run", "subnet", nil)
+	vpc := ts.sweepResource(t, "test-dry-run", "vpc", nil).DependsOn(subnet)
+
+	if err := testSweepOrchestrator([]*sweep.SweepResource{vpc, subnet}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(ts.deleted) != 0 {
+		t.Errorf("got deleted resources %v, expected none", ts.deleted)
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatalf("error reading summary: %s", err)
+	}
+
+	var summary map[string]sweep.RegionSweepSummary
+
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatalf("error decoding summary: %s", err)
+	}
+
+	for _, entry := range summary["test-dry-run"].Skipped {
+		if got, expected := entry.Reason, "dry run"; got != expected {
+			t.Errorf("got %s skip reason %q, expected %q", entry.ID, got, expected)
+		}
+
+		if got, expected := entry.Type, "aws_test"; got != expected {
+			t.Errorf("got %s type %q, expected %q", entry.ID, got, expected)
+		}
+	}
+
+	if got, expected := len(summary["test-dry-run"].Skipped), 2; got != expected {
+		t.Errorf("got %d skipped resources, expected %d", got, expected)
+	}
+}
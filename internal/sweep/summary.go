@@ -0,0 +1,124 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+)
+
+const (
+	sweepStatusDeleted = "deleted"
+	sweepStatusSkipped = "skipped"
+	sweepStatusFailed  = "failed"
+)
+
+// SweepSummaryEntry is a single resource in the sweep summary.
+type SweepSummaryEntry struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// RegionSweepSummary is the sweep summary for a single region.
+type RegionSweepSummary struct {
+	Deleted []SweepSummaryEntry `json:"deleted"`
+	Skipped []SweepSummaryEntry `json:"skipped"`
+	Failed  []SweepSummaryEntry `json:"failed"`
+}
+
+var (
+	sweepSummary      = make(map[string]*RegionSweepSummary)
+	sweepSummaryMutex sync.Mutex
+)
+
+// SweepSummary returns a copy of the summary of all resources swept so far, keyed by region.
+func SweepSummary() map[string]RegionSweepSummary {
+	sweepSummaryMutex.Lock()
+	defer sweepSummaryMutex.Unlock()
+
+	summary := make(map[string]RegionSweepSummary, len(sweepSummary))
+
+	for region, v := range sweepSummary {
+		summary[region] = RegionSweepSummary{
+			Deleted: append([]SweepSummaryEntry{}, v.Deleted...),
+			Skipped: append([]SweepSummaryEntry{}, v.Skipped...),
+			Failed:  append([]SweepSummaryEntry{}, v.Failed...),
+		}
+	}
+
+	return summary
+}
+
+func recordSweepResult(region, status string, entry SweepSummaryEntry) {
+	sweepSummaryMutex.Lock()
+	defer sweepSummaryMutex.Unlock()
+
+	summary, ok := sweepSummary[region]
+
+	if !ok {
+		summary = &RegionSweepSummary{
+			Deleted: []SweepSummaryEntry{},
+			Skipped: []SweepSummaryEntry{},
+			Failed:  []SweepSummaryEntry{},
+		}
+		sweepSummary[region] = summary
+	}
+
+	switch status {
+	case sweepStatusDeleted:
+		summary.Deleted = append(summary.Deleted, entry)
+	case sweepStatusSkipped:
+		summary.Skipped = append(summary.Skipped, entry)
+	case sweepStatusFailed:
+		summary.Failed = append(summary.Failed, entry)
+	}
+}
+
+// writeSweepSummary writes the cumulative sweep summary to the specified file.
+// The file is rewritten after each orchestrator call as sweepers exit the test binary on completion.
+func writeSweepSummary(filename string) error {
+	if filename == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(SweepSummary(), "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding sweep summary: %w", err)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing sweep summary (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+// sweepResult is the outcome of sweeping a single resource.
+// done is closed once the outcome is known.
+type sweepResult struct {
+	done   chan struct{}
+	status string
+}
+
+func (r *sweepResult) delete(sr *SweepResource) {
+	r.status = sweepStatusDeleted
+	recordSweepResult(sr.region(), r.status, SweepSummaryEntry{Type: sr.typeName, ID: sr.id()})
+}
+
+func (r *sweepResult) skip(sr *SweepResource, reason string) {
+	log.Printf("[INFO] Skipping %s (%s): %s", sr.typeName, sr.id(), reason)
+
+	r.status = sweepStatusSkipped
+	recordSweepResult(sr.region(), r.status, SweepSummaryEntry{Type: sr.typeName, ID: sr.id(), Reason: reason})
+}
+
+func (r *sweepResult) fail(sr *SweepResource, err error) {
+	r.status = sweepStatusFailed
+	recordSweepResult(sr.region(), r.status, SweepSummaryEntry{Type: sr.typeName, ID: sr.id(), Reason: err.Error()})
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,24 +64,28 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -95,36 +100,131 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 }
 
 type SweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	typeName     string
+	d            *schema.ResourceData
+	meta         interface{}
+	resource     *schema.Resource
+	dependencies []*SweepResource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
+// NewSweepResource returns a resource of the specified type, e.g. "aws_vpc", to be deleted by the orchestrator.
+// The type name identifies the resource in logs and the sweep summary.
+func NewSweepResource(typeName string, resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
 	return &SweepResource{
+		typeName: typeName,
 		d:        d,
 		meta:     meta,
 		resource: resource,
 	}
 }
 
+// DependsOn declares resources that must be deleted before this resource, e.g. the subnets of a VPC.
+// Dependencies not passed to the same orchestrator call are ignored.
+func (sr *SweepResource) DependsOn(dependencies ...*SweepResource) *SweepResource {
+	sr.dependencies = append(sr.dependencies, dependencies...)
+
+	return sr
+}
+
+func (sr *SweepResource) id() string {
+	return sr.d.Id()
+}
+
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorWithContext deletes the specified resources concurrently, deleting each resource only once
+// all of its dependencies have been deleted.
+// Resources not matching the configured name and tag filters are skipped, as are resources with a dependency that was not deleted.
+// When filtering by tags, resources whose tags weren't set by the sweeper are read first.
+// In dry-run mode no resources are deleted.
 func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	if err := checkDependencyCycles(sweepResources); err != nil {
+		return err
+	}
+
+	options, err := sweepOptionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	results := make(map[*SweepResource]*sweepResult, len(sweepResources))
+
+	for _, sweepResource := range sweepResources {
+		results[sweepResource] = &sweepResult{done: make(chan struct{})}
+	}
+
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
+		result := results[sweepResource]
 
 		g.Go(func() error {
+			defer close(result.done)
+
+			for _, dependency := range sweepResource.dependencies {
+				dependencyResult, ok := results[dependency]
+
+				if !ok {
+					continue
+				}
+
+				<-dependencyResult.done
+
+				if dependencyResult.status != sweepStatusDeleted && !options.dryRun {
+					result.skip(sweepResource, fmt.Sprintf("dependency %s (%s) not deleted", dependency.typeName, dependency.id()))
+
+					return nil
+				}
+			}
+
+			if options.needsRead(sweepResource.resource, sweepResource.d) {
+				id := sweepResource.id()
+
+				if err := readResource(sweepResource.resource, sweepResource.d, sweepResource.meta); err != nil {
+					result.skip(sweepResource, fmt.Sprintf("reading resource: %s", err))
+
+					return nil
+				}
+
+				if sweepResource.d.Id() == "" {
+					sweepResource.d.SetId(id)
+					result.skip(sweepResource, "not found")
+
+					return nil
+				}
+			}
+
+			if !options.matches(sweepResource.d) {
+				result.skip(sweepResource, "does not match sweep filters")
+
+				return nil
+			}
+
+			if options.dryRun {
+				log.Printf("[INFO] Dry run: would delete %s (%s)", sweepResource.typeName, sweepResource.id())
+				result.skip(sweepResource, "dry run")
+
+				return nil
+			}
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
 				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+					if code, ok := retryableSweepError(err); ok {
+						log.Printf("[INFO] While sweeping %s (%s), encountered %s error (%s). Retrying...", sweepResource.typeName, sweepResource.id(), code, err)
 						return resource.RetryableError(err)
 					}
 
@@ -138,11 +238,89 @@ func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepRe
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
-			return err
+			if err != nil {
+				result.fail(sweepResource, err)
+
+				return err
+			}
+
+			result.delete(sweepResource)
+
+			return nil
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err = g.Wait().ErrorOrNil()
+
+	if err := writeSweepSummary(options.summaryFile); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
+	return err
+}
+
+// retryableSweepErrorCodes are the error codes returned while a resource is throttled
+// or still in use by resources that are being deleted concurrently.
+var retryableSweepErrorCodes = []string{
+	"Throttling",
+	"DependencyViolation",
+	"DeleteConflict",
+	"ResourceInUse",
+	"InvalidDBClusterStateFault",
+	"InvalidDBInstanceState",
+}
+
+// retryableSweepError returns the matching error code if the error is retryable.
+// Errors are matched by message as the codes of errors returned by DeleteContext are lost.
+func retryableSweepError(err error) (string, bool) {
+	for _, code := range retryableSweepErrorCodes {
+		if strings.Contains(err.Error(), code) {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+// checkDependencyCycles returns an error if the resources' dependencies contain a cycle.
+func checkDependencyCycles(sweepResources []*SweepResource) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[*SweepResource]int, len(sweepResources))
+
+	var visit func(*SweepResource) error
+
+	visit = func(sr *SweepResource) error {
+		switch state[sr] {
+		case visiting:
+			return fmt.Errorf("sweep resource %s (%s) has a dependency cycle", sr.typeName, sr.id())
+		case visited:
+			return nil
+		}
+
+		state[sr] = visiting
+
+		for _, dependency := range sr.dependencies {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		state[sr] = visited
+
+		return nil
+	}
+
+	for _, sr := range sweepResources {
+		if err := visit(sr); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -233,6 +411,34 @@ func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta inte
 	return resource.Delete(d, meta)
 }
 
+// readResource reads the resource's current state, including its tags, into d.
+// If the resource no longer exists, d's ID is cleared.
+func readResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(context.Background(), d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(context.Background(), d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	if resource.Read == nil {
+		return fmt.Errorf("resource has no read function")
+	}
+
+	return resource.Read(d, meta)
+}
+
 func Partition(region string) string {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
 		return partition.ID()
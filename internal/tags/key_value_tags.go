@@ -26,8 +26,11 @@ type DefaultConfig struct {
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys          KeyValueTags
+	KeyPatterns   []*regexp.Regexp
+	KeyPrefixes   KeyValueTags
+	KeySuffixes   KeyValueTags
+	ValuePatterns []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -95,6 +98,9 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnoreKeyPatterns(config.KeyPatterns)
+	result = result.IgnoreValuePatterns(config.ValuePatterns)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -147,6 +153,63 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyPatterns returns tags whose key doesn't match any of the regular expressions.
+// Unanchored expressions match anywhere in the key. The provider anchors those configured in ignore_tags.
+func (tags KeyValueTags) IgnoreKeyPatterns(ignoreKeyPatterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, re := range ignoreKeyPatterns {
+			if re.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValuePatterns returns tags whose value doesn't match any of the regular expressions.
+// Unanchored expressions match anywhere in the value. The provider anchors those configured in ignore_tags.
+// Tags without a value are matched as the empty string.
+func (tags KeyValueTags) IgnoreValuePatterns(ignoreValuePatterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var value string
+
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		var ignore bool
+
+		for _, re := range ignoreValuePatterns {
+			if re.MatchString(value) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -185,6 +248,30 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -533,6 +534,82 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key suffixes",
+			tags: New(map[string]string{
+				"key1":            "value1",
+				"team-managed-by": "value2",
+				"key3":            "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeySuffixes: New([]string{
+					"-managed-by",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "key patterns",
+			tags: New(map[string]string{
+				"key1":                         "value1",
+				"kubernetes.io/cluster/test":   "owned",
+				"kubernetes.io/role/elb":       "1",
+				"aws:cloudformation:stack-id":  "value4",
+				"aws:cloudformation:stack-key": "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`^aws:cloudformation:.*-id$`),
+				},
+			},
+			want: map[string]string{
+				"key1":                         "value1",
+				"kubernetes.io/role/elb":       "1",
+				"aws:cloudformation:stack-key": "value5",
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "managed-by-tool",
+				"key3": "",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^managed-by-`),
+					regexp.MustCompile(`^$`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(map[string]string{
+				"key1":        "value1",
+				"key2":        "value2",
+				"key3":        "value3",
+				"prefix-key4": "value4",
+				"key5-suffix": "value5",
+				"key6":        "ignored",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:          New([]string{"key1"}),
+				KeyPatterns:   []*regexp.Regexp{regexp.MustCompile(`^key[2]$`)},
+				KeyPrefixes:   New([]string{"prefix-"}),
+				KeySuffixes:   New([]string{"-suffix"}),
+				ValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^ignored$`)},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -689,6 +766,58 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreSuffixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagSuffixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagSuffixes: New([]string{
+				"key1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "all_suffix",
+			tags: New(map[string]string{
+				"key1-managed-by": "value1",
+				"key2-managed-by": "value2",
+			}),
+			ignoreTagSuffixes: New([]string{
+				"-managed-by",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1-managed-by": "value1",
+				"key2":            "value2",
+				"managed-by-key3": "value3",
+			}),
+			ignoreTagSuffixes: New([]string{
+				"-managed-by",
+			}),
+			want: map[string]string{
+				"key2":            "value2",
+				"managed-by-key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreSuffixes(testCase.ignoreTagSuffixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
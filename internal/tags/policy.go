@@ -0,0 +1,145 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Tag policy modes.
+const (
+	// PolicyModeError fails the plan of any resource whose tags don't comply with the policy.
+	PolicyModeError = "error"
+	// PolicyModeWarn logs a warning for any resource whose tags don't comply with the policy.
+	PolicyModeWarn = "warn"
+)
+
+func PolicyMode_Values() []string {
+	return []string{
+		PolicyModeError,
+		PolicyModeWarn,
+	}
+}
+
+// Tag key cases.
+const (
+	KeyCaseCamel  = "camel"  // e.g. costCenter
+	KeyCaseKebab  = "kebab"  // e.g. cost-center
+	KeyCaseLower  = "lower"  // e.g. costcenter
+	KeyCasePascal = "pascal" // e.g. CostCenter
+	KeyCaseSnake  = "snake"  // e.g. cost_center
+	KeyCaseUpper  = "upper"  // e.g. COSTCENTER
+)
+
+func KeyCase_Values() []string {
+	return []string{
+		KeyCaseCamel,
+		KeyCaseKebab,
+		KeyCaseLower,
+		KeyCasePascal,
+		KeyCaseSnake,
+		KeyCaseUpper,
+	}
+}
+
+var keyCaseRegexps = map[string]*regexp.Regexp{
+	KeyCaseCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	KeyCaseKebab:  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	KeyCaseLower:  regexp.MustCompile(`^[^A-Z]*$`),
+	KeyCasePascal: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	KeyCaseSnake:  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	KeyCaseUpper:  regexp.MustCompile(`^[^a-z]*$`),
+}
+
+// PolicyConfig contains the rules that resource tags must comply with.
+type PolicyConfig struct {
+	KeyCase      string // Case of every tag key. AWS reserved (aws:) tag keys are exempt.
+	Mode         string
+	RequiredTags []*RequiredTag
+}
+
+// RequiredTag is a tag that every resource must have.
+type RequiredTag struct {
+	AllowedValuePatterns []*regexp.Regexp
+	AllowedValues        []string
+	Key                  string
+}
+
+// Warn returns whether policy violations should only be logged.
+func (policy *PolicyConfig) Warn() bool {
+	return policy != nil && policy.Mode == PolicyModeWarn
+}
+
+// Violations returns a description of each way in which the tags don't comply with the policy.
+func (policy *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if policy == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, requiredTag := range policy.RequiredTags {
+		if violation := requiredTag.violation(tags); violation != "" {
+			violations = append(violations, violation)
+		}
+	}
+
+	if re, ok := keyCaseRegexps[policy.KeyCase]; ok {
+		for _, k := range tags.IgnoreAWS().Keys() {
+			if !re.MatchString(k) {
+				violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, policy.KeyCase))
+			}
+		}
+	}
+
+	sort.Strings(violations)
+
+	return violations
+}
+
+func (requiredTag *RequiredTag) violation(tags KeyValueTags) string {
+	if _, ok := tags[requiredTag.Key]; !ok {
+		for k := range tags {
+			if strings.EqualFold(k, requiredTag.Key) {
+				return fmt.Sprintf("tag key %q must be %q", k, requiredTag.Key)
+			}
+		}
+
+		return fmt.Sprintf("missing required tag %q", requiredTag.Key)
+	}
+
+	if len(requiredTag.AllowedValues) == 0 && len(requiredTag.AllowedValuePatterns) == 0 {
+		return ""
+	}
+
+	var value string
+
+	if v := tags.KeyValue(requiredTag.Key); v != nil {
+		value = *v
+	}
+
+	for _, allowedValue := range requiredTag.AllowedValues {
+		if value == allowedValue {
+			return ""
+		}
+	}
+
+	for _, re := range requiredTag.AllowedValuePatterns {
+		if re.MatchString(value) {
+			return ""
+		}
+	}
+
+	var allowed []string
+
+	for _, allowedValue := range requiredTag.AllowedValues {
+		allowed = append(allowed, fmt.Sprintf("%q", allowedValue))
+	}
+
+	for _, re := range requiredTag.AllowedValuePatterns {
+		allowed = append(allowed, fmt.Sprintf("/%s/", re))
+	}
+
+	return fmt.Sprintf("tag %q value %q is not one of %s", requiredTag.Key, value, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,106 @@
+package tags
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy *PolicyConfig
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name: "nil policy",
+			tags: New(map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "compliant",
+			policy: &PolicyConfig{
+				KeyCase: KeyCasePascal,
+				RequiredTags: []*RequiredTag{
+					{Key: "CostCenter", AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^cc-[0-9]+$`)}},
+					{Key: "Environment", AllowedValues: []string{"dev", "prod"}},
+					{Key: "Owner"},
+				},
+			},
+			tags: New(map[string]string{
+				"aws:cloudformation:stack-name": "stack",
+				"CostCenter":                    "cc-1234",
+				"Environment":                   "prod",
+				"Owner":                         "team",
+			}),
+		},
+		{
+			name: "missing required tag",
+			policy: &PolicyConfig{
+				RequiredTags: []*RequiredTag{
+					{Key: "CostCenter"},
+					{Key: "Owner"},
+				},
+			},
+			tags: New(map[string]string{"Owner": "team"}),
+			want: []string{`missing required tag "CostCenter"`},
+		},
+		{
+			name: "required tag key case",
+			policy: &PolicyConfig{
+				RequiredTags: []*RequiredTag{
+					{Key: "CostCenter"},
+				},
+			},
+			tags: New(map[string]string{"costcenter": "cc-1234"}),
+			want: []string{`tag key "costcenter" must be "CostCenter"`},
+		},
+		{
+			name: "value not allowed",
+			policy: &PolicyConfig{
+				RequiredTags: []*RequiredTag{
+					{
+						Key:                  "CostCenter",
+						AllowedValues:        []string{"shared"},
+						AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^cc-[0-9]+$`)},
+					},
+				},
+			},
+			tags: New(map[string]string{"CostCenter": "marketing"}),
+			want: []string{`tag "CostCenter" value "marketing" is not one of "shared", /^cc-[0-9]+$/`},
+		},
+		{
+			name: "key case",
+			policy: &PolicyConfig{
+				KeyCase: KeyCaseKebab,
+			},
+			tags: New(map[string]string{
+				"cost-center": "cc-1234",
+				"Owner":       "team",
+				"team_name":   "team",
+			}),
+			want: []string{
+				`tag key "Owner" is not kebab case`,
+				`tag key "team_name" is not kebab case`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.policy.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyCaseRegexps(t *testing.T) {
+	for _, keyCase := range KeyCase_Values() {
+		if _, ok := keyCaseRegexps[keyCase]; !ok {
+			t.Errorf("no regular expression for key case %q", keyCase)
+		}
+	}
+}
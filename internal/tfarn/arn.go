@@ -0,0 +1,133 @@
+package tfarn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// ARN is an Amazon Resource Name (ARN) whose resource has been parsed according to the service's resource grammar.
+type ARN struct {
+	arn.ARN
+
+	// ResourceType is the type of resource, e.g. "key" for a KMS key. Empty for services whose ARNs have no resource type, e.g. SNS topics.
+	ResourceType string
+
+	// ResourcePath is the path of the resource for resource types with paths, e.g. "/division/" for an IAM role. Otherwise empty.
+	ResourcePath string
+
+	// ResourceID is the resource's identifier, e.g. the name of an IAM role or the key ID of a KMS key.
+	ResourceID string
+
+	// Qualifier is the resource qualifier for resource types with qualifiers, e.g. the version of a Lambda function. Otherwise empty.
+	Qualifier string
+}
+
+// Parse parses an ARN, splitting the resource according to the service's resource grammar.
+// ARNs of services or resource types without a known grammar are split at the first "/" or ":" in the resource.
+func Parse(s string) (ARN, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return ARN{}, err
+	}
+
+	v := ARN{ARN: parsedARN}
+
+	if grammar, ok := findResourceGrammar(parsedARN.Service, parsedARN.Resource); ok {
+		v.ResourceType = grammar.Type
+		v.ResourcePath, v.ResourceID, v.Qualifier = grammar.split(strings.TrimPrefix(parsedARN.Resource, grammar.prefix()))
+
+		return v, nil
+	}
+
+	if i := strings.IndexAny(parsedARN.Resource, "/:"); i >= 0 {
+		v.ResourceType, v.ResourceID = parsedARN.Resource[:i], parsedARN.Resource[i+1:]
+	} else {
+		v.ResourceID = parsedARN.Resource
+	}
+
+	return v, nil
+}
+
+// ParseAs parses an ARN and checks that it is the ARN of a resource of the specified service and type.
+func ParseAs(s, service, resourceType string) (ARN, error) {
+	v, err := Parse(s)
+
+	if err != nil {
+		return ARN{}, fmt.Errorf("parsing ARN (%s): %w", s, err)
+	}
+
+	if err := v.Check(service, resourceType); err != nil {
+		return ARN{}, fmt.Errorf("ARN (%s): %w", s, err)
+	}
+
+	return v, nil
+}
+
+// New returns the ARN of a resource of the specified service and type.
+// The resource ID may include any path or qualifier supported by the resource type.
+func New(partition, service, region, accountID, resourceType, resourceID string) (ARN, error) {
+	if partition == "" {
+		return ARN{}, fmt.Errorf("partition is required")
+	}
+
+	grammar, ok := resourceGrammar(service, resourceType)
+
+	if !ok {
+		return ARN{}, fmt.Errorf("unknown %s resource type: %q", service, resourceType)
+	}
+
+	return Parse(arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  grammar.prefix() + resourceID,
+	}.String())
+}
+
+// Is returns whether the ARN is that of a resource of the specified service and type.
+func (v ARN) Is(service, resourceType string) bool {
+	return v.Check(service, resourceType) == nil
+}
+
+// Check returns an error describing why the ARN is not that of a resource of the specified service and type.
+func (v ARN) Check(service, resourceType string) error {
+	if v.Service != service {
+		return fmt.Errorf("expected service %s, got: %s", service, v.Service)
+	}
+
+	if v.ResourceType != resourceType {
+		return fmt.Errorf("expected %s resource type %q, got: %q", service, resourceType, v.ResourceType)
+	}
+
+	grammar, ok := resourceGrammar(service, resourceType)
+
+	if !ok {
+		return nil
+	}
+
+	if grammar.Global && v.Region != "" {
+		return fmt.Errorf("expected no region, got: %s", v.Region)
+	}
+
+	if !grammar.Global && v.Region == "" {
+		return fmt.Errorf("expected region")
+	}
+
+	if grammar.NoAccount && v.AccountID != "" {
+		return fmt.Errorf("expected no account ID, got: %s", v.AccountID)
+	}
+
+	if !grammar.NoAccount && v.AccountID == "" {
+		return fmt.Errorf("expected account ID")
+	}
+
+	if grammar.Separator != "" && v.ResourceID == "" {
+		return fmt.Errorf("expected %s %s ID", service, resourceType)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package tfarn
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputARN             string
+		ExpectedError        bool
+		ExpectedResourceType string
+		ExpectedResourcePath string
+		ExpectedResourceID   string
+		ExpectedQualifier    string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "unparsable ARN",
+			InputARN:      "test",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "IAM role",
+			InputARN:             "arn:aws:iam::123456789012:role/name", // lintignore:AWSAT005
+			ExpectedResourceType: "role",
+			ExpectedResourcePath: "/",
+			ExpectedResourceID:   "name",
+		},
+		{
+			TestName:             "IAM role with path",
+			InputARN:             "arn:aws-us-gov:iam::123456789012:role/division/team/name", // lintignore:AWSAT005
+			ExpectedResourceType: "role",
+			ExpectedResourcePath: "/division/team/",
+			ExpectedResourceID:   "name",
+		},
+		{
+			TestName:             "IAM root",
+			InputARN:             "arn:aws:iam::123456789012:root", // lintignore:AWSAT005
+			ExpectedResourceType: "root",
+		},
+		{
+			TestName:             "KMS key",
+			InputARN:             "arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceType: "key",
+			ExpectedResourceID:   "1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			TestName:             "Lambda function with qualifier",
+			InputARN:             "arn:aws:lambda:us-west-2:123456789012:function:name:PROD", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceType: "function",
+			ExpectedResourceID:   "name",
+			ExpectedQualifier:    "PROD",
+		},
+		{
+			TestName:             "ECS task definition",
+			InputARN:             "arn:aws:ecs:us-west-2:123456789012:task-definition/family:3", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceType: "task-definition",
+			ExpectedResourceID:   "family",
+			ExpectedQualifier:    "3",
+		},
+		{
+			TestName:             "RDS cluster snapshot",
+			InputARN:             "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:name", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceType: "cluster-snapshot",
+			ExpectedResourceID:   "name",
+		},
+		{
+			TestName:           "S3 object",
+			InputARN:           "arn:aws:s3:::bucket/key/name", // lintignore:AWSAT005
+			ExpectedResourceID: "bucket/key/name",
+		},
+		{
+			TestName:           "SNS topic",
+			InputARN:           "arn:aws:sns:us-west-2:123456789012:name", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceID: "name",
+		},
+		{
+			TestName:             "unknown service",
+			InputARN:             "arn:aws:example:us-west-2:123456789012:thing:name/other", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceType: "thing",
+			ExpectedResourceID:   "name/other",
+		},
+		{
+			TestName:           "unknown service without resource type",
+			InputARN:           "arn:aws:example:us-west-2:123456789012:name", // lintignore:AWSAT003,AWSAT005
+			ExpectedResourceID: "name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := Parse(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got.ResourceType != testCase.ExpectedResourceType {
+				t.Errorf("got resource type %q, expected %q", got.ResourceType, testCase.ExpectedResourceType)
+			}
+
+			if got.ResourcePath != testCase.ExpectedResourcePath {
+				t.Errorf("got resource path %q, expected %q", got.ResourcePath, testCase.ExpectedResourcePath)
+			}
+
+			if got.ResourceID != testCase.ExpectedResourceID {
+				t.Errorf("got resource ID %q, expected %q", got.ResourceID, testCase.ExpectedResourceID)
+			}
+
+			if got.Qualifier != testCase.ExpectedQualifier {
+				t.Errorf("got qualifier %q, expected %q", got.Qualifier, testCase.ExpectedQualifier)
+			}
+
+			if !testCase.ExpectedError && got.String() != testCase.InputARN {
+				t.Errorf("got ARN %q, expected %q", got.String(), testCase.InputARN)
+			}
+		})
+	}
+}
+
+func TestParseAs(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		Service       string
+		ResourceType  string
+		ExpectedError bool
+	}{
+		{
+			TestName:     "KMS key",
+			InputARN:     "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT003,AWSAT005
+			Service:      "kms",
+			ResourceType: "key",
+		},
+		{
+			TestName:      "KMS alias",
+			InputARN:      "arn:aws:kms:us-west-2:123456789012:alias/name", // lintignore:AWSAT003,AWSAT005
+			Service:       "kms",
+			ResourceType:  "key",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "KMS key without ID",
+			InputARN:      "arn:aws:kms:us-west-2:123456789012:key/", // lintignore:AWSAT003,AWSAT005
+			Service:       "kms",
+			ResourceType:  "key",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "KMS key without region",
+			InputARN:      "arn:aws:kms::123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT005
+			Service:       "kms",
+			ResourceType:  "key",
+			ExpectedError: true,
+		},
+		{
+			TestName:     "AWS managed IAM policy",
+			InputARN:     "arn:aws:iam::aws:policy/AdministratorAccess", // lintignore:AWSAT005
+			Service:      "iam",
+			ResourceType: "policy",
+		},
+		{
+			TestName:      "IAM policy with region",
+			InputARN:      "arn:aws:iam:us-west-2:123456789012:policy/name", // lintignore:AWSAT003,AWSAT005
+			Service:       "iam",
+			ResourceType:  "policy",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "wrong service",
+			InputARN:      "arn:aws:iam::123456789012:policy/name", // lintignore:AWSAT005
+			Service:       "organizations",
+			ResourceType:  "policy",
+			ExpectedError: true,
+		},
+		{
+			TestName:     "S3 bucket",
+			InputARN:     "arn:aws:s3:::bucket", // lintignore:AWSAT005
+			Service:      "s3",
+			ResourceType: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, err := ParseAs(testCase.InputARN, testCase.Service, testCase.ResourceType)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Partition     string
+		Service       string
+		Region        string
+		AccountID     string
+		ResourceType  string
+		ResourceID    string
+		ExpectedARN   string
+		ExpectedError bool
+	}{
+		{
+			TestName:     "IAM role with path",
+			Partition:    "aws",
+			Service:      "iam",
+			AccountID:    "123456789012",
+			ResourceType: "role",
+			ResourceID:   "division/name",
+			ExpectedARN:  "arn:aws:iam::123456789012:role/division/name", // lintignore:AWSAT005
+		},
+		{
+			TestName:     "Secrets Manager secret",
+			Partition:    "aws-cn",
+			Service:      "secretsmanager",
+			Region:       "cn-north-1", // lintignore:AWSAT003
+			AccountID:    "123456789012",
+			ResourceType: "secret",
+			ResourceID:   "name-a1b2c3",
+			ExpectedARN:  "arn:aws-cn:secretsmanager:cn-north-1:123456789012:secret:name-a1b2c3", // lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:      "unknown resource type",
+			Partition:     "aws",
+			Service:       "kms",
+			Region:        "us-west-2", // lintignore:AWSAT003
+			AccountID:     "123456789012",
+			ResourceType:  "thing",
+			ResourceID:    "name",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "no partition",
+			Service:       "kms",
+			Region:        "us-west-2", // lintignore:AWSAT003
+			AccountID:     "123456789012",
+			ResourceType:  "key",
+			ResourceID:    "name",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := New(testCase.Partition, testCase.Service, testCase.Region, testCase.AccountID, testCase.ResourceType, testCase.ResourceID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectedError && got.String() != testCase.ExpectedARN {
+				t.Errorf("got %q, expected %q", got.String(), testCase.ExpectedARN)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package tfarn
+
+import (
+	"strings"
+)
+
+// ResourceGrammar describes the resource part of a service's ARNs for one type of resource.
+// Reference: https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html
+type ResourceGrammar struct {
+	// Type is the resource type, e.g. "key". Empty for services whose ARNs have no resource type, e.g. SNS topics.
+	Type string
+
+	// Separator separates the resource type from the resource ID, "/" or ":".
+	// Empty for resource types without an ID, e.g. the IAM account root user ("root").
+	Separator string
+
+	// Path indicates that the resource ID may be preceded by a path, e.g. "role/division/name".
+	Path bool
+
+	// Qualifier indicates that the resource ID may be followed by ":" and a qualifier, e.g. "function:name:version".
+	Qualifier bool
+
+	// Global indicates that ARNs have no region.
+	Global bool
+
+	// NoAccount indicates that ARNs have no account ID.
+	NoAccount bool
+}
+
+func (g ResourceGrammar) prefix() string {
+	return g.Type + g.Separator
+}
+
+func (g ResourceGrammar) matches(resource string) bool {
+	if g.Type == "" {
+		return true
+	}
+
+	if g.Separator == "" {
+		return resource == g.Type
+	}
+
+	return strings.HasPrefix(resource, g.prefix())
+}
+
+// split splits the remainder of a resource after the resource type into path, ID and qualifier.
+func (g ResourceGrammar) split(s string) (path, id, qualifier string) {
+	id = s
+
+	if g.Qualifier {
+		if i := strings.Index(id, ":"); i >= 0 {
+			id, qualifier = id[:i], id[i+1:]
+		}
+	}
+
+	if g.Path {
+		path = "/"
+
+		if i := strings.LastIndex(id, "/"); i >= 0 {
+			path, id = "/"+id[:i+1], id[i+1:]
+		}
+	}
+
+	return path, id, qualifier
+}
+
+func slashTypes(types ...string) []ResourceGrammar {
+	grammars := make([]ResourceGrammar, len(types))
+
+	for i, t := range types {
+		grammars[i] = ResourceGrammar{Type: t, Separator: "/"}
+	}
+
+	return grammars
+}
+
+func colonTypes(types ...string) []ResourceGrammar {
+	grammars := make([]ResourceGrammar, len(types))
+
+	for i, t := range types {
+		grammars[i] = ResourceGrammar{Type: t, Separator: ":"}
+	}
+
+	return grammars
+}
+
+func globalTypes(grammars []ResourceGrammar) []ResourceGrammar {
+	for i := range grammars {
+		grammars[i].Global = true
+	}
+
+	return grammars
+}
+
+func concat(grammars ...[]ResourceGrammar) []ResourceGrammar {
+	var result []ResourceGrammar
+
+	for _, v := range grammars {
+		result = append(result, v...)
+	}
+
+	return result
+}
+
+// resourceGrammars are the known resource grammars, keyed by ARN service namespace.
+var resourceGrammars = map[string][]ResourceGrammar{
+	"acm": slashTypes("certificate"),
+	"cloudfront": globalTypes(slashTypes(
+		"cache-policy",
+		"distribution",
+		"function",
+		"origin-access-identity",
+		"origin-request-policy",
+		"realtime-log-config",
+		"response-headers-policy",
+		"streaming-distribution",
+	)),
+	"dynamodb": slashTypes("global-table", "table"),
+	"ec2": slashTypes(
+		"capacity-reservation",
+		"customer-gateway",
+		"dedicated-host",
+		"dhcp-options",
+		"elastic-ip",
+		"fleet",
+		"image",
+		"instance",
+		"internet-gateway",
+		"key-pair",
+		"launch-template",
+		"natgateway",
+		"network-acl",
+		"network-interface",
+		"placement-group",
+		"prefix-list",
+		"route-table",
+		"security-group",
+		"security-group-rule",
+		"snapshot",
+		"spot-instances-request",
+		"subnet",
+		"transit-gateway",
+		"transit-gateway-attachment",
+		"transit-gateway-route-table",
+		"volume",
+		"vpc",
+		"vpc-endpoint",
+		"vpc-endpoint-service",
+		"vpc-flow-log",
+		"vpc-peering-connection",
+		"vpn-connection",
+		"vpn-gateway",
+	),
+	"ecr": slashTypes("repository"),
+	"ecs": concat(
+		slashTypes("capacity-provider", "cluster", "container-instance", "service", "task", "task-set"),
+		[]ResourceGrammar{{Type: "task-definition", Separator: "/", Qualifier: true}},
+	),
+	"eks":                  slashTypes("addon", "cluster", "fargateprofile", "identityproviderconfig", "nodegroup"),
+	"elasticloadbalancing": slashTypes("listener", "listener-rule", "loadbalancer", "targetgroup"),
+	"events":               slashTypes("api-destination", "archive", "connection", "event-bus", "replay", "rule"),
+	"firehose":             slashTypes("deliverystream"),
+	"iam": concat(
+		globalTypes([]ResourceGrammar{
+			{Type: "group", Separator: "/", Path: true},
+			{Type: "instance-profile", Separator: "/", Path: true},
+			{Type: "mfa", Separator: "/", Path: true},
+			{Type: "policy", Separator: "/", Path: true},
+			{Type: "role", Separator: "/", Path: true},
+			{Type: "root"},
+			{Type: "server-certificate", Separator: "/", Path: true},
+			{Type: "user", Separator: "/", Path: true},
+		}),
+		globalTypes(slashTypes("oidc-provider", "saml-provider")),
+	),
+	"kinesis": slashTypes("stream"),
+	"kms":     slashTypes("alias", "key"),
+	"lambda": concat(
+		[]ResourceGrammar{
+			{Type: "function", Separator: ":", Qualifier: true},
+			{Type: "layer", Separator: ":", Qualifier: true},
+		},
+		colonTypes("code-signing-config", "event-source-mapping"),
+	),
+	"logs": {
+		{Type: "destination", Separator: ":"},
+		{Type: "log-group", Separator: ":", Qualifier: true},
+	},
+	"rds": colonTypes("cluster", "cluster-pg", "cluster-snapshot", "db", "es", "og", "pg", "ri", "secgrp", "snapshot", "subgrp"),
+	"route53": {
+		{Type: "healthcheck", Separator: "/", Global: true, NoAccount: true},
+		{Type: "hostedzone", Separator: "/", Global: true, NoAccount: true},
+	},
+	"s3": {
+		// Bucket and object ARNs have no resource type.
+		{Global: true, NoAccount: true},
+		{Type: "accesspoint", Separator: "/"},
+	},
+	"secretsmanager": colonTypes("secret"),
+	"securityhub": concat(
+		[]ResourceGrammar{
+			// Controls and subscriptions are paths below their standard, e.g. "control/cis-aws-foundations-benchmark/v/1.2.0/1.1".
+			{Type: "control", Separator: "/", Path: true},
+			{Type: "subscription", Separator: "/", Path: true},
+		},
+		slashTypes("hub", "product", "standards"),
+	),
+	"sns":    {{}},
+	"sqs":    {{}},
+	"ssm":    slashTypes("association", "document", "maintenancewindow", "parameter", "patchbaseline"),
+	"states": colonTypes("activity", "execution", "stateMachine"),
+	"sts":    globalTypes(slashTypes("assumed-role", "federated-user")),
+}
+
+// findResourceGrammar returns the grammar that matches a service's ARN resource.
+// The grammar with the longest matching resource type is returned.
+func findResourceGrammar(service, resource string) (ResourceGrammar, bool) {
+	var found ResourceGrammar
+	var ok bool
+
+	for _, grammar := range resourceGrammars[service] {
+		if !grammar.matches(resource) {
+			continue
+		}
+
+		if !ok || len(grammar.prefix()) > len(found.prefix()) {
+			found, ok = grammar, true
+		}
+	}
+
+	return found, ok
+}
+
+// resourceGrammar returns the grammar of a service's resource type.
+func resourceGrammar(service, resourceType string) (ResourceGrammar, bool) {
+	for _, grammar := range resourceGrammars[service] {
+		if grammar.Type == resourceType {
+			return grammar, true
+		}
+	}
+
+	return ResourceGrammar{}, false
+}
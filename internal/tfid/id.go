@@ -0,0 +1,227 @@
+package tfid
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const escapeCharacter = `\`
+
+// Format describes a composite resource ID made up of ordered parts joined by a separator,
+// e.g. "cluster-name:addon-name".
+type Format struct {
+	// Parts are the ID's parts, in order.
+	Parts []Part
+
+	// Separator separates the ID's parts.
+	Separator string
+
+	// Escape indicates that occurrences of the separator (and of the escape character, "\") within a part
+	// are escaped with "\" so that any part value can round trip.
+	// Only enable escaping for new resources, as it changes the IDs of values containing "\".
+	Escape bool
+}
+
+// Part describes one part of a composite resource ID.
+type Part struct {
+	// Name is the part's name, used in error messages, e.g. "cluster-name".
+	Name string
+
+	// Optional indicates that the part may be omitted from the ID.
+	// When parsing an ID with too few parts, optional parts are omitted from left to right and take their Default value.
+	Optional bool
+
+	// Default is the value of an omitted optional part.
+	// An optional part whose value is empty or Default is omitted from the ID.
+	Default string
+
+	// Greedy indicates that the part's value may contain the separator.
+	// When parsing an ID with too many parts, the greedy part absorbs the extra parts.
+	// At most one part of an ID may be greedy.
+	Greedy bool
+
+	// Pattern, if set, must match the part's value when parsing an ID.
+	Pattern *regexp.Regexp
+}
+
+// NewFormat returns the format of a composite resource ID made up of the named, required parts.
+func NewFormat(separator string, names ...string) Format {
+	parts := make([]Part, len(names))
+
+	for i, name := range names {
+		parts[i] = Part{Name: name}
+	}
+
+	return Format{
+		Parts:     parts,
+		Separator: separator,
+	}
+}
+
+// ID returns the composite resource ID of the specified part values, which must be in the order of the format's parts.
+func (f Format) ID(values ...string) string {
+	var elems []string
+
+	for i, value := range values {
+		if i < len(f.Parts) {
+			if part := f.Parts[i]; part.Optional && (value == "" || value == part.Default) {
+				continue
+			}
+		}
+
+		if f.Escape {
+			value = strings.ReplaceAll(value, escapeCharacter, escapeCharacter+escapeCharacter)
+			value = strings.ReplaceAll(value, f.Separator, escapeCharacter+f.Separator)
+		}
+
+		elems = append(elems, value)
+	}
+
+	return strings.Join(elems, f.Separator)
+}
+
+// Parse parses a composite resource ID, returning the values of all the format's parts in order.
+// The values of omitted optional parts are their defaults.
+func (f Format) Parse(id string) ([]string, error) {
+	values, ok := f.parse(id)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected %s", id, f)
+	}
+
+	return values, nil
+}
+
+// String returns a description of the format, e.g. "EVENTBUSNAME/RULENAME or RULENAME".
+func (f Format) String() string {
+	var full, required []string
+
+	for _, part := range f.Parts {
+		full = append(full, part.Name)
+
+		if !part.Optional {
+			required = append(required, part.Name)
+		}
+	}
+
+	s := strings.Join(full, f.Separator)
+
+	if len(required) < len(full) {
+		s += " or " + strings.Join(required, f.Separator)
+	}
+
+	return s
+}
+
+func (f Format) parse(id string) ([]string, bool) {
+	if id == "" {
+		return nil, false
+	}
+
+	elems := f.split(id)
+	nParts := len(f.Parts)
+
+	// Work out which parts are present in the ID.
+	present := make([]bool, nParts)
+	nPresent := nParts
+
+	for i := range present {
+		present[i] = true
+	}
+
+	for i, part := range f.Parts {
+		if nPresent <= len(elems) {
+			break
+		}
+
+		if part.Optional {
+			present[i] = false
+			nPresent--
+		}
+	}
+
+	if nPresent > len(elems) {
+		return nil, false
+	}
+
+	greedy := -1
+
+	if nPresent < len(elems) {
+		for i, part := range f.Parts {
+			if part.Greedy && present[i] {
+				greedy = i
+				break
+			}
+		}
+
+		if greedy < 0 {
+			return nil, false
+		}
+	}
+
+	values := make([]string, nParts)
+	extra := len(elems) - nPresent
+
+	for i, part := range f.Parts {
+		if !present[i] {
+			values[i] = part.Default
+			continue
+		}
+
+		n := 1
+
+		if i == greedy {
+			n += extra
+		}
+
+		value := strings.Join(elems[:n], f.Separator)
+		elems = elems[n:]
+
+		if value == "" {
+			return nil, false
+		}
+
+		if part.Pattern != nil && !part.Pattern.MatchString(value) {
+			return nil, false
+		}
+
+		values[i] = value
+	}
+
+	return values, true
+}
+
+// split splits an ID at each separator, unescaping the resulting parts if the format is escaped.
+func (f Format) split(id string) []string {
+	if !f.Escape {
+		return strings.Split(id, f.Separator)
+	}
+
+	var elems []string
+	var sb strings.Builder
+
+	for i := 0; i < len(id); {
+		switch {
+		case strings.HasPrefix(id[i:], escapeCharacter) && i+len(escapeCharacter) < len(id):
+			i += len(escapeCharacter)
+
+			if strings.HasPrefix(id[i:], f.Separator) {
+				sb.WriteString(f.Separator)
+				i += len(f.Separator)
+			} else {
+				sb.WriteByte(id[i])
+				i++
+			}
+		case strings.HasPrefix(id[i:], f.Separator):
+			elems = append(elems, sb.String())
+			sb.Reset()
+			i += len(f.Separator)
+		default:
+			sb.WriteByte(id[i])
+			i++
+		}
+	}
+
+	return append(elems, sb.String())
+}
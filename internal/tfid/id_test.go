@@ -0,0 +1,214 @@
+package tfid
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestFormatID(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Format     Format
+		Values     []string
+		ExpectedID string
+	}{
+		{
+			TestName:   "required parts",
+			Format:     NewFormat(":", "cluster-name", "addon-name"),
+			Values:     []string{"cluster", "addon"},
+			ExpectedID: "cluster:addon",
+		},
+		{
+			TestName: "optional part present",
+			Format: Format{
+				Parts:     []Part{{Name: "EVENTBUSNAME", Optional: true, Default: "default"}, {Name: "RULENAME"}},
+				Separator: "/",
+			},
+			Values:     []string{"bus", "rule"},
+			ExpectedID: "bus/rule",
+		},
+		{
+			TestName: "optional part default",
+			Format: Format{
+				Parts:     []Part{{Name: "EVENTBUSNAME", Optional: true, Default: "default"}, {Name: "RULENAME"}},
+				Separator: "/",
+			},
+			Values:     []string{"default", "rule"},
+			ExpectedID: "rule",
+		},
+		{
+			TestName: "optional part empty",
+			Format: Format{
+				Parts:     []Part{{Name: "EVENTBUSNAME", Optional: true, Default: "default"}, {Name: "RULENAME"}},
+				Separator: "/",
+			},
+			Values:     []string{"", "rule"},
+			ExpectedID: "rule",
+		},
+		{
+			TestName: "escaped",
+			Format: Format{
+				Parts:     []Part{{Name: "a"}, {Name: "b"}},
+				Separator: ",",
+				Escape:    true,
+			},
+			Values:     []string{`x,y`, `z\`},
+			ExpectedID: `x\,y,z\\`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := testCase.Format.ID(testCase.Values...)
+
+			if got != testCase.ExpectedID {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+func TestFormatParse(t *testing.T) {
+	ruleFormat := Format{
+		Parts: []Part{
+			{Name: "EVENTBUSNAME", Optional: true, Default: "default", Greedy: true, Pattern: regexp.MustCompile(`^[^/]+$|^aws\.partner(/[^/]+){2,}$`)},
+			{Name: "RULENAME"},
+		},
+		Separator: "/",
+	}
+	escapedFormat := Format{
+		Parts:     []Part{{Name: "a"}, {Name: "b"}},
+		Separator: ",",
+		Escape:    true,
+	}
+
+	testCases := []struct {
+		TestName       string
+		Format         Format
+		InputID        string
+		ExpectedError  bool
+		ExpectedValues []string
+	}{
+		{
+			TestName:      "empty ID",
+			Format:        NewFormat(":", "cluster-name", "addon-name"),
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:       "required parts",
+			Format:         NewFormat(":", "cluster-name", "addon-name"),
+			InputID:        "cluster:addon",
+			ExpectedValues: []string{"cluster", "addon"},
+		},
+		{
+			TestName:      "too few parts",
+			Format:        NewFormat(":", "cluster-name", "addon-name"),
+			InputID:       "cluster",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			Format:        NewFormat(":", "cluster-name", "addon-name"),
+			InputID:       "cluster:addon:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty part",
+			Format:        NewFormat(":", "cluster-name", "addon-name"),
+			InputID:       "cluster:",
+			ExpectedError: true,
+		},
+		{
+			TestName:       "optional part omitted",
+			Format:         ruleFormat,
+			InputID:        "rule",
+			ExpectedValues: []string{"default", "rule"},
+		},
+		{
+			TestName:       "optional part present",
+			Format:         ruleFormat,
+			InputID:        "bus/rule",
+			ExpectedValues: []string{"bus", "rule"},
+		},
+		{
+			TestName:       "greedy part",
+			Format:         ruleFormat,
+			InputID:        "aws.partner/example.com/Test/rule",
+			ExpectedValues: []string{"aws.partner/example.com/Test", "rule"},
+		},
+		{
+			TestName:      "greedy part not matching pattern",
+			Format:        ruleFormat,
+			InputID:       "bus/other/rule",
+			ExpectedError: true,
+		},
+		{
+			TestName:       "greedy last part",
+			Format:         Format{Parts: []Part{{Name: "listener-arn"}, {Name: "certificate-arn", Greedy: true}}, Separator: "_"},
+			InputID:        "listener_certificate_name",
+			ExpectedValues: []string{"listener", "certificate_name"},
+		},
+		{
+			TestName:       "escaped",
+			Format:         escapedFormat,
+			InputID:        `x\,y,z\\`,
+			ExpectedValues: []string{`x,y`, `z\`},
+		},
+		{
+			TestName:      "escaped too many parts",
+			Format:        escapedFormat,
+			InputID:       `x,y,z`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := testCase.Format.Parse(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedValues) {
+				t.Errorf("got %q, expected %q", got, testCase.ExpectedValues)
+			}
+		})
+	}
+}
+
+func TestFormatString(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Format   Format
+		Expected string
+	}{
+		{
+			TestName: "required parts",
+			Format:   NewFormat(":", "cluster-name", "addon-name"),
+			Expected: "cluster-name:addon-name",
+		},
+		{
+			TestName: "optional part",
+			Format: Format{
+				Parts:     []Part{{Name: "EVENTBUSNAME", Optional: true}, {Name: "RULENAME"}, {Name: "TARGETID"}},
+				Separator: "/",
+			},
+			Expected: "EVENTBUSNAME/RULENAME/TARGETID or RULENAME/TARGETID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := testCase.Format.String(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
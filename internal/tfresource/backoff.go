@@ -0,0 +1,104 @@
+package tfresource
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+// backoff computes exponentially increasing delays with jitter.
+// The zero value uses the default minimum and maximum delays.
+type backoff struct {
+	min     time.Duration // Delay before the second attempt.
+	max     time.Duration // Upper bound for any delay.
+	attempt int
+}
+
+// next returns the delay before the next attempt.
+// The delay doubles on each call, capped at `max`, and is then jittered into the range [delay/2, delay)
+// so that concurrent callers don't hit the API in lockstep. The delay is never less than `min`.
+func (b *backoff) next() time.Duration {
+	min, max := b.min, b.max
+
+	if min <= 0 {
+		min = defaultMinBackoff
+	}
+
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+
+	if min > max {
+		max = min
+	}
+
+	delay := min
+	for i := 0; i < b.attempt && delay < max; i++ {
+		delay *= 2
+	}
+
+	if delay > max {
+		delay = max
+	}
+
+	b.attempt++
+
+	if delay = jitter(delay); delay < min {
+		delay = min
+	}
+
+	return delay
+}
+
+// reset restarts the backoff sequence from the minimum delay.
+func (b *backoff) reset() {
+	b.attempt = 0
+}
+
+// jitter returns a random duration in the range [d/2, d).
+func jitter(d time.Duration) time.Duration {
+	half := int64(d / 2)
+
+	if half <= 0 {
+		return d
+	}
+
+	return time.Duration(half + rand.Int63n(half))
+}
+
+// sleepContext sleeps for the specified duration or until the context is done, whichever comes first.
+// If the context is done before the duration elapses, the context's error is returned.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// sleepUntil sleeps for the specified duration, cut short at `deadline`.
+// It returns `false` if the deadline has been reached.
+func sleepUntil(ctx context.Context, d time.Duration, deadline time.Time) (bool, error) {
+	if remaining := time.Until(deadline); remaining < d {
+		d = remaining
+	}
+
+	if err := sleepContext(ctx, d); err != nil {
+		return false, err
+	}
+
+	return time.Now().Before(deadline), nil
+}
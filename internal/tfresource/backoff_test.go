@@ -0,0 +1,48 @@
+package tfresource
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffNext(t *testing.T) {
+	b := &backoff{min: 100 * time.Millisecond, max: 1 * time.Second}
+
+	for i := 0; i < 10; i++ {
+		got := b.next()
+
+		if got < b.min {
+			t.Errorf("attempt %d: delay %s less than minimum %s", i, got, b.min)
+		}
+
+		if got > b.max {
+			t.Errorf("attempt %d: delay %s greater than maximum %s", i, got, b.max)
+		}
+
+		// Upper bound for this attempt before jitter.
+		upper := b.min << i
+		if upper > b.max {
+			upper = b.max
+		}
+
+		if got > upper {
+			t.Errorf("attempt %d: delay %s greater than expected upper bound %s", i, got, upper)
+		}
+	}
+
+	b.reset()
+
+	if got := b.next(); got > b.min {
+		t.Errorf("after reset: delay %s greater than minimum %s", got, b.min)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	d := 1 * time.Second
+
+	for i := 0; i < 100; i++ {
+		if got := jitter(d); got < d/2 || got >= d {
+			t.Fatalf("jitter(%s) = %s, expected in range [%s, %s)", d, got, d/2, d)
+		}
+	}
+}
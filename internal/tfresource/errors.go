@@ -2,6 +2,7 @@ package tfresource
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -24,6 +25,33 @@ func TimedOut(err error) bool {
 	return ok && timeoutErr.LastError == nil
 }
 
+// Canceled returns true if the error represents a "context canceled" condition.
+// Specifically, Canceled returns true if the error or a wrapped error is of type
+// CanceledError.
+func Canceled(err error) bool {
+	var e *CanceledError
+	return errors.As(err, &e)
+}
+
+// CanceledError is returned by the retry and wait functions when the context
+// is canceled (or its deadline is exceeded) before the operation completes.
+type CanceledError struct {
+	Err       error // The context's error, either context.Canceled or context.DeadlineExceeded.
+	LastError error // The last error returned by the retried or polled function, if any.
+}
+
+func (e *CanceledError) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("%s, last error: %s", e.Err, e.LastError)
+	}
+
+	return e.Err.Error()
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
 // SetLastError sets the LastError field on the error if supported.
 // If lastErr is nil it is ignored.
 func SetLastError(err, lastErr error) {
@@ -37,5 +65,10 @@ func SetLastError(err, lastErr error) {
 		if err.LastError == nil {
 			err.LastError = lastErr
 		}
+
+	case *CanceledError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
 	}
 }
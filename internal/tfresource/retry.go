@@ -3,8 +3,8 @@ package tfresource
 import (
 	"context"
 	"errors"
+	"log"
 	"math/rand"
-	"sync"
 	"time"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -18,32 +18,20 @@ import (
 type Retryable func(error) (bool, error)
 
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
-// `f` is retried until `timeout` expires.
+// `f` is retried until `timeout` expires or the context is canceled.
+// Waits between calls to `f` using exponential backoff with jitter.
+// If the context is canceled a *CanceledError is returned.
 func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
 	var output interface{}
 
-	err := resource.Retry(timeout, func() *resource.RetryError { // nosemgrep: helper-schema-resource-Retry-without-TimeoutError-check
+	err := retryContext(ctx, timeout, new(backoff).next, func() (bool, error) {
 		var err error
-		var retry bool
 
 		output, err = f()
-		retry, err = retryable(err)
-
-		if retry {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
 
-		return nil
+		return retryable(err)
 	})
 
-	if TimedOut(err) {
-		output, err = f()
-	}
-
 	if err != nil {
 		return nil, err
 	}
@@ -127,74 +115,89 @@ func RetryWhenNewResourceNotFound(timeout time.Duration, f func() (interface{},
 	return RetryWhenNewResourceNotFoundContext(context.Background(), timeout, f, isNewResource)
 }
 
-// RetryConfigContext allows configuration of StateChangeConf's various time arguments.
+// RetryConfigContext allows configuration of the retry loop's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
-// the default durations cause problems. To not use a StateChangeConf argument and revert to the
+// the default durations cause problems. To not use an argument and revert to the
 // default, pass in a zero value (i.e., 0*time.Second).
+// `minTimeout` is the starting delay for exponential backoff; `pollInterval` overrides backoff
+// and polls at a fixed interval.
+// If the context is canceled a *CanceledError is returned.
 func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration, f resource.RetryFunc) error {
-	// These are used to pull the error out of the function; need a mutex to
-	// avoid a data race.
-	var resultErr error
-	var resultErrMu sync.Mutex
-
-	c := &resource.StateChangeConf{
-		Pending: []string{"retryableerror"},
-		Target:  []string{"success"},
-		Timeout: timeout,
-		Refresh: func() (interface{}, string, error) {
-			rerr := f()
-
-			resultErrMu.Lock()
-			defer resultErrMu.Unlock()
-
-			if rerr == nil {
-				resultErr = nil
-				return 42, "success", nil
-			}
-
-			resultErr = rerr.Err
-
-			if rerr.Retryable {
-				return 42, "retryableerror", nil
-			}
-
-			return nil, "quit", rerr.Err
-		},
-	}
-
-	if delay.Milliseconds() > 0 {
-		c.Delay = delay
-	}
-
 	if delayRand.Milliseconds() > 0 {
 		// Hitting the API at exactly the same time on each iteration of the retry is more likely to
 		// cause Throttling problems. We introduce randomness in order to help AWS be happier.
-		rand.Seed(time.Now().UTC().UnixNano())
-
-		c.Delay = time.Duration(rand.Int63n(delayRand.Milliseconds())) * time.Millisecond
+		delay = time.Duration(rand.Int63n(delayRand.Milliseconds())) * time.Millisecond
 	}
 
-	if minTimeout.Milliseconds() > 0 {
-		c.MinTimeout = minTimeout
+	deadline := time.Now().Add(timeout)
+
+	if delay > 0 {
+		if _, err := sleepUntil(ctx, delay, deadline); err != nil {
+			return &CanceledError{Err: err}
+		}
 	}
 
+	wait := (&backoff{min: minTimeout}).next
+
 	if pollInterval.Milliseconds() > 0 {
-		c.PollInterval = pollInterval
+		wait = func() time.Duration { return pollInterval }
 	}
 
-	_, waitErr := c.WaitForStateContext(ctx)
+	return retryContext(ctx, time.Until(deadline), wait, func() (bool, error) {
+		if rerr := f(); rerr != nil {
+			return rerr.Retryable, rerr.Err
+		}
 
-	// Need to acquire the lock here to be able to avoid race using resultErr as
-	// the return value
-	resultErrMu.Lock()
-	defer resultErrMu.Unlock()
+		return false, nil
+	})
+}
 
-	// resultErr may be nil because the wait timed out and resultErr was never
-	// set; this is still an error
-	if resultErr == nil {
-		return waitErr
+// retryContext calls `f` until it returns `false`, `timeout` expires or the context is canceled.
+// `f` is always called at least once. Between calls it waits for the duration returned by `wait`.
+// Once `timeout` expires `f` is called one final time; if it still asks to be retried, its error is returned.
+// If the context is canceled, a *CanceledError wrapping the context's error is returned.
+func retryContext(ctx context.Context, timeout time.Duration, wait func() time.Duration, f func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	var lastErr error
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return &CanceledError{Err: err, LastError: lastErr}
+		}
+
+		retry, err := f()
+
+		if !retry {
+			return err
+		}
+
+		lastErr = err
+
+		log.Printf("[DEBUG] Retrying after error: %s", err)
+
+		more, cerr := sleepUntil(ctx, wait(), deadline)
+
+		if cerr != nil {
+			return &CanceledError{Err: cerr, LastError: lastErr}
+		}
+
+		if !more {
+			break
+		}
 	}
-	// resultErr takes precedence over waitErr if both are set because it is
-	// more likely to be useful
-	return resultErr
+
+	// Make one last attempt now that the deadline has passed, so that an operation that
+	// completed while waiting isn't reported as timed out.
+	retry, err := f()
+
+	if !retry {
+		return err
+	}
+
+	if err == nil {
+		return &resource.TimeoutError{Timeout: timeout}
+	}
+
+	return err
 }
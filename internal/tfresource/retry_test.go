@@ -308,3 +308,148 @@ func TestRetryConfigContext_error(t *testing.T) {
 		t.Fatal("timeout")
 	}
 }
+
+func TestRetryWhenContext_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	retryable := errors.New("retryable")
+
+	var calls int32
+	f := func() (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			cancel()
+		}
+
+		return nil, retryable
+	}
+
+	start := time.Now()
+	_, err := tfresource.RetryWhenContext(ctx, 1*time.Minute, f, func(err error) (bool, error) {
+		return true, err
+	})
+
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Fatalf("retry did not stop promptly on cancellation: %s", elapsed)
+	}
+
+	if !tfresource.Canceled(err) {
+		t.Fatalf("expected CanceledError, got: %#v", err)
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected error to wrap context.Canceled, got: %s", err)
+	}
+
+	var cancelErr *tfresource.CanceledError
+	if errors.As(err, &cancelErr) && !errors.Is(cancelErr.LastError, retryable) {
+		t.Errorf("unexpected last error: %s", cancelErr.LastError)
+	}
+}
+
+func TestRetryConfigContext_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	f := func() *resource.RetryError {
+		atomic.AddInt32(&calls, 1)
+
+		return resource.RetryableError(errors.New("retryable"))
+	}
+
+	err := tfresource.RetryConfigContext(ctx, 0*time.Second, 0*time.Second, 0*time.Second, 0*time.Second, 1*time.Minute, f)
+
+	if !tfresource.Canceled(err) {
+		t.Fatalf("expected CanceledError, got: %#v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("expected no calls after cancellation, got %d", got)
+	}
+}
+
+func TestRetryWhenContext_finalAttempt(t *testing.T) {
+	t.Parallel()
+
+	retryable := errors.New("retryable")
+	deadline := time.Now().Add(1 * time.Second)
+
+	var calls int32
+	f := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+
+		if time.Now().Before(deadline) {
+			return nil, retryable
+		}
+
+		return "done", nil
+	}
+
+	output, err := tfresource.RetryWhenContext(context.Background(), 1*time.Second, f, func(err error) (bool, error) {
+		return err != nil, err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := output, "done"; got != expected {
+		t.Errorf("got %v, expected %s", got, expected)
+	}
+}
+
+func TestRetryWhenContext_timeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Err           error
+		ExpectTimeout bool
+	}{
+		{
+			Name:          "nil error",
+			ExpectTimeout: true,
+		},
+		{
+			Name: "error",
+			Err:  errors.New("retryable"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			timeout := 1 * time.Second
+			deadline := time.Now().Add(timeout)
+
+			var lastCall time.Time
+			f := func() (interface{}, error) {
+				lastCall = time.Now()
+
+				return nil, testCase.Err
+			}
+
+			_, err := tfresource.RetryWhenContext(context.Background(), timeout, f, func(err error) (bool, error) {
+				return true, err
+			})
+
+			if got, expected := tfresource.TimedOut(err), testCase.ExpectTimeout; got != expected {
+				t.Errorf("got TimedOut %t, expected %t (error: %v)", got, expected, err)
+			}
+
+			if testCase.Err != nil && err != testCase.Err {
+				t.Errorf("got %v, expected %s", err, testCase.Err)
+			}
+
+			if lastCall.Before(deadline) {
+				t.Errorf("expected a final attempt after the deadline")
+			}
+		})
+	}
+}
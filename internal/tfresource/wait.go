@@ -15,7 +15,6 @@ type WaitOpts struct {
 }
 
 const (
-	targetStateError = "ERROR"
 	targetStateFalse = "FALSE"
 	targetStateTrue  = "TRUE"
 )
@@ -23,36 +22,76 @@ const (
 // WaitUntilContext waits for the function `f` to return `true`.
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
-// Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
+// If the context is canceled, return a *CanceledError.
+// Waits between calls to `f` using exponential backoff with jitter, except when waiting for the target state to reoccur.
 func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
-	refresh := func() (interface{}, string, error) {
+	deadline := time.Now().Add(timeout)
+
+	if opts.Delay > 0 {
+		if _, err := sleepUntil(ctx, opts.Delay, deadline); err != nil {
+			return &CanceledError{Err: err}
+		}
+	}
+
+	continuousTargetOccurence := opts.ContinuousTargetOccurence
+	if continuousTargetOccurence <= 0 {
+		continuousTargetOccurence = 1
+	}
+
+	b := &backoff{min: opts.MinTimeout}
+	targetOccurence := 0
+	lastState := ""
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return &CanceledError{Err: err}
+		}
+
 		done, err := f()
 
 		if err != nil {
-			return nil, targetStateError, err
+			return err
 		}
 
+		var wait time.Duration
+
 		if done {
-			return "", targetStateTrue, nil
+			lastState = targetStateTrue
+			targetOccurence++
+
+			if targetOccurence >= continuousTargetOccurence {
+				return nil
+			}
+
+			// Don't back off while waiting for the target state to reoccur.
+			b.reset()
+			wait = b.next()
+		} else {
+			lastState = targetStateFalse
+			targetOccurence = 0
+			wait = b.next()
 		}
 
-		return "", targetStateFalse, nil
-	}
+		if opts.PollInterval > 0 {
+			wait = opts.PollInterval
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{targetStateFalse},
-		Target:                    []string{targetStateTrue},
-		Refresh:                   refresh,
-		Timeout:                   timeout,
-		ContinuousTargetOccurence: opts.ContinuousTargetOccurence,
-		Delay:                     opts.Delay,
-		MinTimeout:                opts.MinTimeout,
-		PollInterval:              opts.PollInterval,
-	}
+		more, err := sleepUntil(ctx, wait, deadline)
 
-	_, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return &CanceledError{Err: err}
+		}
 
-	return err
+		if !more {
+			break
+		}
+	}
+
+	return &resource.TimeoutError{
+		LastState:     lastState,
+		Timeout:       timeout,
+		ExpectedState: []string{targetStateTrue},
+	}
 }
 
 // WaitUntil waits for the function `f` to return `true`.
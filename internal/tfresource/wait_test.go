@@ -1,6 +1,7 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
@@ -63,3 +64,27 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitUntilContext_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := tfresource.WaitUntilContext(ctx, 1*time.Minute, func() (bool, error) {
+		return false, nil
+	}, tfresource.WaitOpts{})
+
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Fatalf("wait did not stop promptly on cancellation: %s", elapsed)
+	}
+
+	if !tfresource.Canceled(err) {
+		t.Fatalf("expected CanceledError, got: %#v", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to wrap context.DeadlineExceeded, got: %s", err)
+	}
+}
@@ -3,6 +3,8 @@ package verify
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,7 +20,7 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -30,6 +32,10 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if err := checkTagPolicy(diff, resourceTypeFromContext(ctx), meta.(*conns.AWSClient).TagPolicyConfig, allTags); err != nil {
+		return err
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -53,6 +59,58 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+type resourceTypeContextKey struct{}
+
+// WithResourceType returns a copy of ctx carrying the Terraform resource type, e.g. "aws_vpc",
+// so that plan-time errors such as tag policy violations can name the resource.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+// resourceTypeFromContext returns the Terraform resource type carried by ctx, or "resource" if there is none.
+func resourceTypeFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(resourceTypeContextKey{}).(string); ok && v != "" {
+		return v
+	}
+
+	return "resource"
+}
+
+// checkTagPolicy returns an error if the merged resource tags don't comply with the provider-level tag policy.
+// In warn mode any violations are logged instead.
+// Tags that are not yet known, e.g. interpolated from another resource's attributes, are not checked.
+func checkTagPolicy(diff *schema.ResourceDiff, resourceType string, policy *tftags.PolicyConfig, allTags tftags.KeyValueTags) error {
+	if policy == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	violations := policy.Violations(allTags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	resource := tagPolicyResourceDescription(resourceType, diff.Id())
+
+	if policy.Warn() {
+		log.Printf("[WARN] tags of %s do not comply with the provider tag_policy: %s", resource, strings.Join(violations, "; "))
+
+		return nil
+	}
+
+	return fmt.Errorf("tags of %s do not comply with the provider tag_policy: %s", resource, strings.Join(violations, "; "))
+}
+
+// tagPolicyResourceDescription describes the resource in tag policy violations,
+// e.g. "new aws_vpc" when planning its creation or "aws_vpc (vpc-12345678)".
+func tagPolicyResourceDescription(resourceType, id string) string {
+	if id == "" {
+		return "new " + resourceType
+	}
+
+	return fmt.Sprintf("%s (%s)", resourceType, id)
+}
+
 // SuppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified), but
 // confusing behavior exists when converting bare true/false values with state.
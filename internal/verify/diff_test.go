@@ -1,6 +1,7 @@
 package verify
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -144,3 +145,31 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestTagPolicyResourceDescription(t *testing.T) {
+	testCases := []struct {
+		ctx      context.Context
+		id       string
+		expected string
+	}{
+		{
+			ctx:      WithResourceType(context.Background(), "aws_vpc"),
+			expected: "new aws_vpc",
+		},
+		{
+			ctx:      WithResourceType(context.Background(), "aws_vpc"),
+			id:       "vpc-12345678",
+			expected: "aws_vpc (vpc-12345678)",
+		},
+		{
+			ctx:      context.Background(),
+			expected: "new resource",
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tagPolicyResourceDescription(resourceTypeFromContext(tc.ctx), tc.id); got != tc.expected {
+			t.Errorf("got %q, expected %q", got, tc.expected)
+		}
+	}
+}
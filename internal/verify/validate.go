@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfarn"
 )
 
 var accountIDRegexp = regexp.MustCompile(`^(aws|\d{12})$`)
@@ -53,6 +54,37 @@ func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 	return ws, errors
 }
 
+// ValidARNOfType returns a SchemaValidateFunc which tests if the provided value
+// is the ARN of a resource of the specified service and resource type, e.g. ("kms", "key").
+func ValidARNOfType(service, resourceType string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		ws, errors = ValidARN(v, k)
+
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		value := v.(string)
+
+		if value == "" {
+			return ws, errors
+		}
+
+		parsedARN, err := tfarn.Parse(value)
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			return ws, errors
+		}
+
+		if err := parsedARN.Check(service, resourceType); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a %s %s ARN: %s", k, value, service, resourceType, err))
+		}
+
+		return ws, errors
+	}
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
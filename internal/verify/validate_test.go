@@ -186,6 +186,36 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNOfType(t *testing.T) {
+	f := ValidARNOfType("kms", "key")
+
+	validNames := []string{
+		"",
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",            // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := f(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid KMS key ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"arn:aws:kms:us-west-2:123456789012:alias/test",                      // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:key/",                            // lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms::123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:policy/test",                              // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := f(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid KMS key ARN", v)
+		}
+	}
+}
+
 func TestValidateCIDRBlock(t *testing.T) {
 	for _, ts := range []struct {
 		cidr  string